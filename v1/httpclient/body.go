@@ -0,0 +1,26 @@
+package httpclient
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel func()
+}
+
+func (b cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func bytesReader(body []byte) io.Reader {
+	if body == nil {
+		return http.NoBody
+	}
+
+	return bytes.NewReader(body)
+}
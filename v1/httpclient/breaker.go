@@ -0,0 +1,23 @@
+package httpclient
+
+import (
+	"errors"
+)
+
+var ErrBreakerOpen = errors.New("httpclient: circuit breaker open")
+
+type breakerError struct {
+	err error
+}
+
+func (e *breakerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *breakerError) Unwrap() error {
+	return e.err
+}
+
+func (e *breakerError) Is(target error) bool {
+	return target == ErrBreakerOpen
+}
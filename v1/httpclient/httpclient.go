@@ -0,0 +1,229 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+)
+
+type (
+	client struct {
+		http         *http.Client
+		timeout      time.Duration
+		maxRetries   int
+		retryWait    time.Duration
+		maxRetryWait time.Duration
+		retryOn      func(resp *http.Response, err error) bool
+		retryAll     bool
+		breaker      Breaker
+	}
+
+	Breaker interface {
+		Name() string
+		Allow(ctx context.Context) (done func(success bool), err error)
+	}
+
+	Config struct {
+		Timeout      time.Duration
+		MaxRetries   int
+		RetryWait    time.Duration
+		MaxRetryWait time.Duration
+		RetryOn      func(resp *http.Response, err error) bool
+		// RetryNonIdempotent also retries POST and PATCH requests that carry
+		// no Idempotency-Key header.
+		RetryNonIdempotent bool
+		Breaker            Breaker
+		Transport          http.RoundTripper
+	}
+
+	Itf interface {
+		Do(req *http.Request) (*http.Response, error)
+		Get(ctx context.Context, url string) (*http.Response, error)
+		Post(ctx context.Context, url, contentType string, body []byte) (*http.Response, error)
+	}
+)
+
+const (
+	defaultRetryWait    = 100 * time.Millisecond
+	defaultMaxRetryWait = 2 * time.Second
+)
+
+func New(cfg Config) Itf {
+	c := &client{
+		http:         &http.Client{Transport: otel.WrapTransport(cfg.Transport)},
+		timeout:      cfg.Timeout,
+		maxRetries:   cfg.MaxRetries,
+		retryWait:    cfg.RetryWait,
+		maxRetryWait: cfg.MaxRetryWait,
+		retryOn:      cfg.RetryOn,
+		retryAll:     cfg.RetryNonIdempotent,
+		breaker:      cfg.Breaker,
+	}
+	if c.retryWait <= 0 {
+		c.retryWait = defaultRetryWait
+	}
+	if c.maxRetryWait <= 0 {
+		c.maxRetryWait = defaultMaxRetryWait
+	}
+	if c.retryOn == nil {
+		c.retryOn = retryable
+	}
+
+	return c
+}
+
+func (c *client) Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req)
+}
+
+func (c *client) Post(ctx context.Context, url, contentType string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytesReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return c.Do(req)
+}
+
+func (c *client) Do(req *http.Request) (*http.Response, error) {
+	maxAttempts := c.maxRetries + 1
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		maxAttempts = 1
+	}
+	if !c.retryAll && !idempotent(req) {
+		maxAttempts = 1
+	}
+
+	ctx := req.Context()
+	name := "HTTP " + req.Method
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			if err := c.wait(ctx, attempt); err != nil {
+				return nil, err
+			}
+		}
+
+		if maxAttempts == 1 {
+			resp, err = c.attempt(ctx, req, attempt)
+			return resp, err
+		}
+
+		retry := false
+		ctx, _ = otel.TraceRetry(ctx, name, attempt, maxAttempts, func(ctx context.Context) error {
+			resp, err = c.attempt(ctx, req, attempt)
+			retry = attempt < maxAttempts && c.retryOn(resp, err) && ctx.Err() == nil
+			if err != nil {
+				return err
+			}
+			if retry {
+				return errors.New(resp.Status)
+			}
+
+			return nil
+		})
+		if !retry || errors.Is(err, ErrBreakerOpen) {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+
+	return resp, err
+}
+
+func (c *client) attempt(ctx context.Context, req *http.Request, attempt int) (*http.Response, error) {
+	r := req.Clone(ctx)
+	if attempt > 1 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	done := func(bool) {}
+	if c.breaker != nil {
+		var err error
+		done, err = c.breaker.Allow(ctx)
+		if err != nil {
+			otel.RecordBreakerRejection(ctx, c.breaker.Name(), otel.BreakerOpen, err)
+			return nil, &breakerError{err: err}
+		}
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if c.timeout > 0 {
+		var timeoutCtx context.Context
+		timeoutCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		r = r.WithContext(timeoutCtx)
+	}
+
+	resp, err := c.http.Do(r)
+	done(err == nil && resp.StatusCode < http.StatusInternalServerError)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+func (c *client) wait(ctx context.Context, attempt int) error {
+	backoff := c.retryWait << uint(attempt-2)
+	if backoff <= 0 || backoff > c.maxRetryWait {
+		backoff = c.maxRetryWait
+	}
+
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(backoff) + 1)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		var breakerErr *breakerError
+		return !errors.As(err, &breakerErr)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
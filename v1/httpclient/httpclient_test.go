@@ -0,0 +1,173 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		key    string
+		want   bool
+	}{
+		{http.MethodGet, "", true},
+		{http.MethodHead, "", true},
+		{http.MethodOptions, "", true},
+		{http.MethodTrace, "", true},
+		{http.MethodPut, "", true},
+		{http.MethodDelete, "", true},
+		{http.MethodPost, "", false},
+		{http.MethodPatch, "", false},
+		{http.MethodPost, "abc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+"/"+tt.key, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "http://example.com", nil)
+			if tt.key != "" {
+				req.Header.Set("Idempotency-Key", tt.key)
+			}
+
+			if got := idempotent(req); got != tt.want {
+				t.Errorf("idempotent(%s, key=%q) = %v, want %v", tt.method, tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		code int
+		err  error
+		want bool
+	}{
+		{"transport error", 0, errors.New("connection reset"), true},
+		{"breaker error", 0, &breakerError{err: errors.New("open")}, false},
+		{"ok", http.StatusOK, nil, false},
+		{"bad request", http.StatusBadRequest, nil, false},
+		{"too many requests", http.StatusTooManyRequests, nil, true},
+		{"internal server error", http.StatusInternalServerError, nil, false},
+		{"bad gateway", http.StatusBadGateway, nil, true},
+		{"service unavailable", http.StatusServiceUnavailable, nil, true},
+		{"gateway timeout", http.StatusGatewayTimeout, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.code}
+			}
+
+			if got := retryable(resp, tt.err); got != tt.want {
+				t.Errorf("retryable(%d, %v) = %v, want %v", tt.code, tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          Config
+		method       string
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{"get retried", Config{MaxRetries: 2}, http.MethodGet, 3, http.StatusServiceUnavailable},
+		{"post not retried", Config{MaxRetries: 2}, http.MethodPost, 1, http.StatusServiceUnavailable},
+		{"post not retried with custom retry on", Config{MaxRetries: 2, RetryOn: retryable}, http.MethodPost, 1, http.StatusServiceUnavailable},
+		{"post retried when allowed", Config{MaxRetries: 2, RetryNonIdempotent: true}, http.MethodPost, 3, http.StatusServiceUnavailable},
+		{"no retries", Config{}, http.MethodGet, 1, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			tt.cfg.RetryWait = time.Millisecond
+			tt.cfg.MaxRetryWait = time.Millisecond
+			c := New(tt.cfg)
+
+			var body []byte
+			if tt.method == http.MethodPost {
+				body = []byte(`{}`)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, server.URL, bytesReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := atomic.LoadInt32(&attempts); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+type stubBreaker struct {
+	err     error
+	results []bool
+}
+
+func (b *stubBreaker) Name() string {
+	return "stub"
+}
+
+func (b *stubBreaker) Allow(context.Context) (func(success bool), error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	return func(success bool) {
+		b.results = append(b.results, success)
+	}, nil
+}
+
+func TestDoBreaker(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+	}))
+	defer server.Close()
+
+	open := &stubBreaker{err: errors.New("open")}
+	_, err := New(Config{MaxRetries: 2, Breaker: open}).Get(context.Background(), server.URL)
+	if !errors.Is(err, ErrBreakerOpen) {
+		t.Errorf("Get with open breaker = %v, want %v", err, ErrBreakerOpen)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 0 {
+		t.Errorf("attempts with open breaker = %d, want 0", got)
+	}
+
+	closed := &stubBreaker{}
+	resp, err := New(Config{Breaker: closed}).Get(context.Background(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if len(closed.results) != 1 || !closed.results[0] {
+		t.Errorf("breaker results = %v, want [true]", closed.results)
+	}
+}
@@ -0,0 +1,54 @@
+package loggerecho
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/logger"
+	"github.com/labstack/echo/v4"
+)
+
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			err := next(c)
+
+			statusCode := c.Response().Status
+			if err != nil {
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					statusCode = httpError.Code
+				} else if !c.Response().Committed {
+					statusCode = http.StatusInternalServerError
+				}
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = c.Request().URL.Path
+			}
+
+			logger.LogHTTPRequest(c.Request().Context(), c.Request().Method, route, statusCode, int(c.Response().Size), time.Since(start))
+
+			return err
+		}
+	}
+}
+
+func Recovery() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					logger.RecoverPanic(c.Request().Context(), rec)
+					err = echo.NewHTTPError(http.StatusInternalServerError)
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package logger
+
+import (
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type FileOutput struct {
+	Path       string
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+	Compress   bool
+}
+
+func newFileCore(file FileOutput, encoding string) zapcore.Core {
+	writer := &lumberjack.Logger{
+		Filename:   file.Path,
+		MaxSize:    file.MaxSizeMB,
+		MaxAge:     file.MaxAgeDays,
+		MaxBackups: file.MaxBackups,
+		Compress:   file.Compress,
+	}
+
+	return zapcore.NewCore(newEncoder(encoding), zapcore.AddSync(writer), level)
+}
@@ -0,0 +1,37 @@
+package loggergin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/logger"
+	"github.com/gin-gonic/gin"
+)
+
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+
+		logger.LogHTTPRequest(c.Request.Context(), c.Request.Method, route, c.Writer.Status(), c.Writer.Size(), time.Since(start))
+	}
+}
+
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				logger.RecoverPanic(c.Request.Context(), rec)
+				c.AbortWithStatus(http.StatusInternalServerError)
+			}
+		}()
+
+		c.Next()
+	}
+}
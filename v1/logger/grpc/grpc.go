@@ -0,0 +1,177 @@
+package loggergrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/logger"
+	"github.com/elraghifary/go-modules/v1/mask"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Option interface {
+	apply(config) config
+}
+
+type config struct {
+	LogPayloads bool
+}
+
+type option func(config) config
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithPayloads() Option {
+	return option(func(config config) config {
+		config.LogPayloads = true
+		return config
+	})
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	config config
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		logPayload(s.Context(), s.config, "grpc.request", m)
+	}
+
+	return err
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	logPayload(s.Context(), s.config, "grpc.response", m)
+	return s.ServerStream.SendMsg(m)
+}
+
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		logPayload(ctx, cfg, "grpc.request", req)
+		resp, err := handler(ctx, req)
+		if err == nil {
+			logPayload(ctx, cfg, "grpc.response", resp)
+		}
+
+		logRPC(ctx, info.FullMethod, err, time.Since(start))
+
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, &serverStream{ServerStream: ss, config: cfg})
+		logRPC(ss.Context(), info.FullMethod, err, time.Since(start))
+
+		return err
+	}
+}
+
+func newConfig(opts []Option) config {
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	return cfg
+}
+
+func logRPC(ctx context.Context, fullMethod string, err error, duration time.Duration) {
+	code := status.Code(err)
+
+	fields := []zap.Field{
+		zap.String("rpc.system", "grpc"),
+		zap.String("rpc.method", fullMethod),
+		zap.String("rpc.grpc.status_code", code.String()),
+		zap.Float64("rpc.duration_ms", float64(duration.Microseconds())/1000),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields = append(fields, zap.String("net.peer.address", p.Addr.String()))
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	logger.FromContext(ctx).Check(levelOf(code), "rpc").Write(fields...)
+}
+
+func logPayload(ctx context.Context, cfg config, message string, payload interface{}) {
+	if !cfg.LogPayloads {
+		return
+	}
+
+	l := logger.FromContext(ctx)
+	if !l.Core().Enabled(zapcore.DebugLevel) {
+		return
+	}
+
+	m, ok := payload.(proto.Message)
+	if !ok {
+		return
+	}
+
+	body, err := protojson.Marshal(m)
+	if err != nil {
+		return
+	}
+
+	l.Debug(message, zap.ByteString("rpc.payload", mask.Body("application/json", body)))
+}
+
+func levelOf(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		return zapcore.InfoLevel
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange, codes.ResourceExhausted, codes.Aborted:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.ErrorLevel
+	}
+}
+
+func UnaryRecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				logger.RecoverPanic(ctx, rec)
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamRecoveryInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				logger.RecoverPanic(ss.Context(), rec)
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
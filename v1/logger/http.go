@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	size        int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+
+	return n, err
+}
+
+func (w *responseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not implement http.Hijacker")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && !w.wroteHeader {
+		w.statusCode = http.StatusSwitchingProtocols
+		w.wroteHeader = true
+	}
+
+	return conn, rw, err
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func HTTPMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(rw, r)
+
+		LogHTTPRequest(r.Context(), r.Method, r.URL.Path, rw.statusCode, rw.size, time.Since(start))
+	})
+}
+
+func LogHTTPRequest(ctx context.Context, method, route string, statusCode, size int, latency time.Duration) {
+	fields := []zap.Field{
+		zap.String("http.method", method),
+		zap.String("http.route", route),
+		zap.Int("http.status_code", statusCode),
+		zap.Int("http.response_content_length", size),
+		zap.Float64("http.duration_ms", float64(latency.Microseconds())/1000),
+	}
+
+	l := fromContext(ctx, wrapped.Load())
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		l.Error("access", fields...)
+	case statusCode >= http.StatusBadRequest:
+		l.Warn("access", fields...)
+	default:
+		l.Info("access", fields...)
+	}
+}
@@ -0,0 +1,27 @@
+package logger
+
+import (
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func SetLevel(value string) error {
+	l, err := zapcore.ParseLevel(strings.ToLower(value))
+	if err != nil {
+		return err
+	}
+
+	level.SetLevel(l)
+
+	return nil
+}
+
+func Level() string {
+	return level.String()
+}
+
+func LevelHandler() http.Handler {
+	return level
+}
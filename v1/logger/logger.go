@@ -0,0 +1,262 @@
+package logger
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/contrib/bridges/otelzap"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/credentials"
+)
+
+type (
+	logger struct {
+		serviceName  string
+		collectorURL string
+		insecure     string
+		protocol     string
+		headers      map[string]string
+	}
+
+	Config struct {
+		ServiceName  string
+		Level        string
+		Encoding     string
+		CollectorURL string
+		Insecure     string
+		Protocol     string
+		Headers      map[string]string
+		MaskKeys     []string
+		MaskPatterns []string
+		Sampling     []Sampling
+		File         FileOutput
+		SentryDSN    string
+		Environment  string
+	}
+
+	Itf interface {
+		InitLogger() func(context.Context) error
+		FromContext(ctx context.Context) *zap.Logger
+		Debug(ctx context.Context, message string, fields ...zap.Field)
+		Info(ctx context.Context, message string, fields ...zap.Field)
+		Warn(ctx context.Context, message string, fields ...zap.Field)
+		Error(ctx context.Context, message string, fields ...zap.Field)
+		Fatal(ctx context.Context, message string, fields ...zap.Field)
+		SetLevel(level string) error
+		LevelHandler() http.Handler
+		Sync() error
+	}
+)
+
+var (
+	base           atomic.Pointer[zap.Logger]
+	wrapped        atomic.Pointer[zap.Logger]
+	level          = zap.NewAtomicLevel()
+	mu             sync.RWMutex
+	serviceName    string
+	cores          []zapcore.Core
+	loggerProvider *sdklog.LoggerProvider
+)
+
+func init() {
+	base.Store(zap.NewNop())
+	wrapped.Store(zap.NewNop())
+}
+
+func New(cfg Config) Itf {
+	level.SetLevel(parseLevel(cfg.Level))
+	mask.AddKeys(cfg.MaskKeys...)
+	if err := mask.AddPatterns(cfg.MaskPatterns...); err != nil {
+		log.Printf("Invalid mask pattern: %v", err)
+	}
+	newCores := []zapcore.Core{zapcore.NewCore(newEncoder(cfg.Encoding), zapcore.Lock(os.Stdout), level), spanCore{}}
+	if cfg.File.Path != "" {
+		newCores = append(newCores, newFileCore(cfg.File, cfg.Encoding))
+	}
+	if cfg.SentryDSN != "" {
+		err := sentry.Init(sentry.ClientOptions{
+			Dsn:         cfg.SentryDSN,
+			Environment: cfg.Environment,
+			ServerName:  cfg.ServiceName,
+		})
+		if err != nil {
+			log.Printf("Failed to initialize sentry: %v", err)
+		} else {
+			newCores = append(newCores, sentryCore{})
+		}
+	}
+
+	mu.Lock()
+	serviceName = cfg.ServiceName
+	samplings = cfg.Sampling
+	cores = newCores
+	build()
+	mu.Unlock()
+
+	return &logger{
+		serviceName:  cfg.ServiceName,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+		headers:      cfg.Headers,
+	}
+}
+
+func (l *logger) InitLogger() func(context.Context) error {
+	exporter, err := l.newExporter()
+	if err != nil {
+		log.Fatalf("Failed to create log exporter: %v", err)
+	}
+
+	resources, err := resource.New(
+		context.Background(),
+		resource.WithAttributes(
+			attribute.String("service.name", l.serviceName),
+			attribute.String("library.language", "go"),
+		),
+	)
+	if err != nil {
+		log.Fatalf("Could not set resources: %v", err)
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(resources),
+	)
+	global.SetLoggerProvider(provider)
+
+	otelCore, err := zapcore.NewIncreaseLevelCore(otelzap.NewCore(l.serviceName, otelzap.WithLoggerProvider(provider)), level)
+	if err != nil {
+		log.Fatalf("Failed to create otlp log core: %v", err)
+	}
+
+	mu.Lock()
+	loggerProvider = provider
+	cores = append(cores, otelCore)
+	build()
+	mu.Unlock()
+
+	return provider.Shutdown
+}
+
+func (l *logger) newExporter() (sdklog.Exporter, error) {
+	secure := strings.ToLower(l.insecure) == "false" || l.insecure == "0" || strings.ToLower(l.insecure) == "f"
+
+	if strings.ToLower(l.protocol) == "http" {
+		options := []otlploghttp.Option{otlploghttp.WithEndpoint(l.collectorURL), otlploghttp.WithHeaders(l.headers)}
+		if !secure {
+			options = append(options, otlploghttp.WithInsecure())
+		}
+
+		return otlploghttp.New(context.Background(), options...)
+	}
+
+	options := []otlploggrpc.Option{otlploggrpc.WithEndpoint(l.collectorURL), otlploggrpc.WithHeaders(l.headers)}
+	if secure {
+		options = append(options, otlploggrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	} else {
+		options = append(options, otlploggrpc.WithInsecure())
+	}
+
+	return otlploggrpc.New(context.Background(), options...)
+}
+
+func build() {
+	masked := make([]zapcore.Core, len(cores))
+	for i, core := range cores {
+		masked[i] = maskCore{Core: core}
+	}
+
+	l := zap.New(newSamplingCore(zapcore.NewTee(masked...)), zap.AddCaller()).With(zap.String("service.name", serviceName))
+	base.Store(l)
+	wrapped.Store(l.WithOptions(zap.AddCallerSkip(1)))
+}
+
+func FromContext(ctx context.Context) *zap.Logger {
+	return fromContext(ctx, base.Load())
+}
+
+func fromContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return l
+	}
+
+	return l.With(
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+		zap.Field{Key: "context", Type: zapcore.SkipType, Interface: ctx},
+	)
+}
+
+func (l *logger) FromContext(ctx context.Context) *zap.Logger {
+	return FromContext(ctx)
+}
+
+func (l *logger) Debug(ctx context.Context, message string, fields ...zap.Field) {
+	fromContext(ctx, wrapped.Load()).Debug(message, fields...)
+}
+
+func (l *logger) Info(ctx context.Context, message string, fields ...zap.Field) {
+	fromContext(ctx, wrapped.Load()).Info(message, fields...)
+}
+
+func (l *logger) Warn(ctx context.Context, message string, fields ...zap.Field) {
+	fromContext(ctx, wrapped.Load()).Warn(message, fields...)
+}
+
+func (l *logger) Error(ctx context.Context, message string, fields ...zap.Field) {
+	fromContext(ctx, wrapped.Load()).Error(message, fields...)
+}
+
+func (l *logger) Fatal(ctx context.Context, message string, fields ...zap.Field) {
+	fromContext(ctx, wrapped.Load()).Fatal(message, fields...)
+}
+
+func (l *logger) SetLevel(value string) error {
+	return SetLevel(value)
+}
+
+func (l *logger) LevelHandler() http.Handler {
+	return LevelHandler()
+}
+
+func (l *logger) Sync() error {
+	return base.Load().Sync()
+}
+
+func newEncoder(encoding string) zapcore.Encoder {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "timestamp"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	if strings.ToLower(encoding) == "console" {
+		return zapcore.NewConsoleEncoder(encoderConfig)
+	}
+
+	return zapcore.NewJSONEncoder(encoderConfig)
+}
+
+func parseLevel(value string) zapcore.Level {
+	l, err := zapcore.ParseLevel(strings.ToLower(value))
+	if err != nil {
+		return zapcore.InfoLevel
+	}
+
+	return l
+}
@@ -0,0 +1,118 @@
+package logger
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type maskCore struct {
+	zapcore.Core
+}
+
+func (c maskCore) With(fields []zapcore.Field) zapcore.Core {
+	return maskCore{Core: c.Core.With(maskFields(fields))}
+}
+
+func (c maskCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c maskCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = mask.String(entry.Message)
+
+	return c.Core.Write(entry, maskFields(fields))
+}
+
+func maskFields(fields []zapcore.Field) []zapcore.Field {
+	masked := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		masked[i] = maskField(field)
+	}
+
+	return masked
+}
+
+func maskField(field zapcore.Field) zapcore.Field {
+	if field.Type == zapcore.SkipType {
+		return field
+	}
+
+	if mask.IsKey(field.Key) {
+		return zap.String(field.Key, mask.Value)
+	}
+
+	switch field.Type {
+	case zapcore.StringType:
+		field.String = mask.String(field.String)
+	case zapcore.ByteStringType:
+		field = zap.ByteString(field.Key, []byte(mask.String(string(field.Interface.([]byte)))))
+	case zapcore.StringerType, zapcore.ReflectType, zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType:
+		field = maskStructuredField(field)
+	}
+
+	return field
+}
+
+func maskStructuredField(field zapcore.Field) zapcore.Field {
+	encoder := zapcore.NewMapObjectEncoder()
+	field.AddTo(encoder)
+
+	value := encoder.Fields[field.Key]
+	if str, ok := value.(string); ok {
+		return zap.String(field.Key, mask.String(str))
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return zap.String(field.Key, mask.Value)
+	}
+
+	return zap.Reflect(field.Key, json.RawMessage(mask.Body("application/json", body)))
+}
+
+func maskAttr(attr slog.Attr) slog.Attr {
+	if mask.IsKey(attr.Key) {
+		return slog.String(attr.Key, mask.Value)
+	}
+
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, mask.String(attr.Value.String()))
+	case slog.KindGroup:
+		attrs := attr.Value.Group()
+		masked := make([]any, len(attrs))
+		for i, item := range attrs {
+			masked[i] = maskAttr(item)
+		}
+		return slog.Group(attr.Key, masked...)
+	}
+
+	return attr
+}
+
+func maskRecord(record slog.Record) slog.Record {
+	masked := slog.NewRecord(record.Time, record.Level, mask.String(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		masked.AddAttrs(maskAttr(attr))
+		return true
+	})
+
+	return masked
+}
+
+func maskAttrs(attrs []slog.Attr) []slog.Attr {
+	masked := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		masked[i] = maskAttr(attr)
+	}
+
+	return masked
+}
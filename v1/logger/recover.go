@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+)
+
+func RecoverPanic(ctx context.Context, r interface{}) error {
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("panic: %v", r)
+	}
+
+	fromContext(ctx, wrapped.Load()).Error("panic recovered",
+		zap.Error(err),
+		zap.Bool("exception.escaped", true),
+		zap.String("exception.stacktrace", string(debug.Stack())),
+	)
+
+	return err
+}
+
+func RecoveryMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			RecoverPanic(r.Context(), rec)
+			w.WriteHeader(http.StatusInternalServerError)
+		}()
+
+		h.ServeHTTP(w, r)
+	})
+}
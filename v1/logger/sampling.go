@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	Sampling struct {
+		Level      string
+		Tick       time.Duration
+		First      int
+		Thereafter int
+	}
+
+	samplingCore struct {
+		zapcore.Core
+		samplers map[zapcore.Level]zapcore.Core
+	}
+)
+
+var samplings []Sampling
+
+func newSamplingCore(core zapcore.Core) zapcore.Core {
+	if len(samplings) == 0 {
+		return core
+	}
+
+	samplers := make(map[zapcore.Level]zapcore.Core, len(samplings))
+	for _, s := range samplings {
+		l, err := zapcore.ParseLevel(strings.ToLower(s.Level))
+		if err != nil {
+			continue
+		}
+
+		tick := s.Tick
+		if tick <= 0 {
+			tick = time.Second
+		}
+		samplers[l] = zapcore.NewSamplerWithOptions(core, tick, s.First, s.Thereafter)
+	}
+
+	return samplingCore{Core: core, samplers: samplers}
+}
+
+func (c samplingCore) With(fields []zapcore.Field) zapcore.Core {
+	samplers := make(map[zapcore.Level]zapcore.Core, len(c.samplers))
+	for l, sampler := range c.samplers {
+		samplers[l] = sampler.With(fields)
+	}
+
+	return samplingCore{Core: c.Core.With(fields), samplers: samplers}
+}
+
+func (c samplingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if sampler, ok := c.samplers[entry.Level]; ok {
+		return sampler.Check(entry, checked)
+	}
+
+	return c.Core.Check(entry, checked)
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+const sentryFlushTimeout = 2 * time.Second
+
+type sentryCore struct {
+	ctx    context.Context
+	fields []zapcore.Field
+}
+
+func (c sentryCore) Enabled(l zapcore.Level) bool {
+	return l >= zapcore.ErrorLevel && level.Enabled(l)
+}
+
+func (c sentryCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := sentryCore{ctx: c.ctx, fields: append(append([]zapcore.Field{}, c.fields...), fields...)}
+	for _, field := range fields {
+		if ctx, ok := field.Interface.(context.Context); ok && field.Type == zapcore.SkipType {
+			clone.ctx = ctx
+		}
+	}
+
+	return clone
+}
+
+func (c sentryCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c sentryCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range append(append([]zapcore.Field{}, c.fields...), fields...) {
+		field.AddTo(encoder)
+	}
+
+	event := sentry.NewEvent()
+	event.Level = sentry.LevelError
+	if entry.Level >= zapcore.FatalLevel {
+		event.Level = sentry.LevelFatal
+	}
+	event.Message = entry.Message
+	event.Timestamp = entry.Time
+	event.Logger = entry.LoggerName
+	event.Extra = encoder.Fields
+	event.Threads = []sentry.Thread{{Stacktrace: sentry.NewStacktrace(), Current: true}}
+
+	if c.ctx != nil {
+		if spanContext := trace.SpanContextFromContext(c.ctx); spanContext.IsValid() {
+			event.Tags = map[string]string{
+				"trace_id": spanContext.TraceID().String(),
+				"span_id":  spanContext.SpanID().String(),
+			}
+		}
+	}
+
+	sentry.CaptureEvent(event)
+
+	if entry.Level > zapcore.ErrorLevel {
+		sentry.Flush(sentryFlushTimeout)
+	}
+
+	return nil
+}
+
+func (c sentryCore) Sync() error {
+	sentry.Flush(sentryFlushTimeout)
+	return nil
+}
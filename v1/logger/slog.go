@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+type Handler struct {
+	handler slog.Handler
+	otel    slog.Handler
+}
+
+func NewHandler(handler slog.Handler) *Handler {
+	if handler == nil {
+		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{AddSource: true, Level: slog.LevelDebug})
+	}
+
+	mu.RLock()
+	name, provider := serviceName, loggerProvider
+	mu.RUnlock()
+
+	h := &Handler{handler: handler.WithAttrs([]slog.Attr{slog.String("service.name", name)})}
+	if provider != nil {
+		h.otel = otelslog.NewHandler(name, otelslog.WithLoggerProvider(provider))
+	}
+
+	return h
+}
+
+func (h *Handler) Enabled(_ context.Context, l slog.Level) bool {
+	return level.Enabled(toZapLevel(l))
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	record = maskRecord(record)
+
+	if h.otel != nil {
+		_ = h.otel.Handle(ctx, record)
+	}
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+
+	return h.handler.Handle(ctx, record)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	attrs = maskAttrs(attrs)
+
+	clone := &Handler{handler: h.handler.WithAttrs(attrs)}
+	if h.otel != nil {
+		clone.otel = h.otel.WithAttrs(attrs)
+	}
+
+	return clone
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	clone := &Handler{handler: h.handler.WithGroup(name)}
+	if h.otel != nil {
+		clone.otel = h.otel.WithGroup(name)
+	}
+
+	return clone
+}
+
+func toZapLevel(l slog.Level) zapcore.Level {
+	switch {
+	case l >= slog.LevelError:
+		return zapcore.ErrorLevel
+	case l >= slog.LevelWarn:
+		return zapcore.WarnLevel
+	case l >= slog.LevelInfo:
+		return zapcore.InfoLevel
+	default:
+		return zapcore.DebugLevel
+	}
+}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+)
+
+type spanCore struct {
+	ctx    context.Context
+	fields []zapcore.Field
+}
+
+func (c spanCore) Enabled(l zapcore.Level) bool {
+	return l >= zapcore.ErrorLevel && level.Enabled(l)
+}
+
+func (c spanCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := spanCore{ctx: c.ctx, fields: append([]zapcore.Field{}, c.fields...)}
+	for _, field := range fields {
+		if ctx, ok := field.Interface.(context.Context); ok && field.Type == zapcore.SkipType {
+			clone.ctx = ctx
+			continue
+		}
+		clone.fields = append(clone.fields, field)
+	}
+
+	return clone
+}
+
+func (c spanCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.ctx != nil && c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c spanCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	span := trace.SpanFromContext(c.ctx)
+	if !span.IsRecording() {
+		return nil
+	}
+
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range append(append([]zapcore.Field{}, c.fields...), fields...) {
+		field.AddTo(encoder)
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.ExceptionMessageKey.String(entry.Message),
+		attribute.String("log.severity", entry.Level.String()),
+	}
+	if entry.Stack != "" {
+		attributes = append(attributes, semconv.ExceptionStacktraceKey.String(entry.Stack))
+	}
+	for key, value := range encoder.Fields {
+		attributes = append(attributes, toAttribute(key, value))
+	}
+
+	span.AddEvent(semconv.ExceptionEventName, trace.WithAttributes(attributes...))
+	span.SetStatus(codes.Error, entry.Message)
+
+	return nil
+}
+
+func (c spanCore) Sync() error {
+	return nil
+}
+
+func toAttribute(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
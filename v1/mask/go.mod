@@ -0,0 +1,3 @@
+module github.com/elraghifary/go-modules/v1/mask
+
+go 1.18
@@ -0,0 +1,194 @@
+package mask
+
+import (
+	"encoding/json"
+	"mime"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const Value = "***"
+
+type Masker func(contentType string, body []byte) []byte
+
+var (
+	DefaultKeys = []string{
+		"password",
+		"token",
+		"access_token",
+		"refresh_token",
+		"secret",
+		"authorization",
+		"card_number",
+		"cvv",
+		"pin",
+	}
+
+	mu       sync.RWMutex
+	keys     = newKeys(DefaultKeys)
+	patterns []*regexp.Regexp
+	maskers  = []Masker{maskJSON, maskForm}
+)
+
+func AddKeys(k ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, key := range k {
+		keys[strings.ToLower(key)] = struct{}{}
+	}
+}
+
+func AddPatterns(p ...string) error {
+	compiled := make([]*regexp.Regexp, 0, len(p))
+	for _, pattern := range p {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		compiled = append(compiled, re)
+	}
+
+	mu.Lock()
+	patterns = append(patterns, compiled...)
+	mu.Unlock()
+
+	return nil
+}
+
+func AddMasker(masker Masker) {
+	mu.Lock()
+	maskers = append(maskers, masker)
+	mu.Unlock()
+}
+
+func IsKey(key string) bool {
+	mu.RLock()
+	_, ok := keys[strings.ToLower(key)]
+	mu.RUnlock()
+
+	return ok
+}
+
+func String(value string) string {
+	mu.RLock()
+	patterns := patterns
+	mu.RUnlock()
+
+	for _, re := range patterns {
+		value = re.ReplaceAllString(value, Value)
+	}
+
+	return value
+}
+
+func Field(key, value string) string {
+	if IsKey(key) {
+		return Value
+	}
+
+	return String(value)
+}
+
+func Body(contentType string, body []byte) []byte {
+	mu.RLock()
+	maskers := maskers
+	mu.RUnlock()
+
+	for _, masker := range maskers {
+		body = masker(contentType, body)
+	}
+
+	return body
+}
+
+func newKeys(k []string) map[string]struct{} {
+	m := make(map[string]struct{}, len(k))
+	for _, key := range k {
+		m[strings.ToLower(key)] = struct{}{}
+	}
+
+	return m
+}
+
+func maskJSON(contentType string, body []byte) []byte {
+	if !IsJSONContentType(contentType) {
+		return body
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	masked, err := json.Marshal(maskJSONValue(v))
+	if err != nil {
+		return body
+	}
+
+	return masked
+}
+
+func maskJSONValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if IsKey(key) {
+				value[key] = Value
+				continue
+			}
+			value[key] = maskJSONValue(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = maskJSONValue(item)
+		}
+	case string:
+		return String(value)
+	}
+
+	return v
+}
+
+func maskForm(contentType string, body []byte) []byte {
+	if !IsFormContentType(contentType) {
+		return body
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+
+	for key, items := range values {
+		if IsKey(key) {
+			values.Set(key, Value)
+			continue
+		}
+		for i, item := range items {
+			items[i] = String(item)
+		}
+	}
+
+	return []byte(values.Encode())
+}
+
+func IsJSONContentType(contentType string) bool {
+	mediaType := MediaType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func IsFormContentType(contentType string) bool {
+	return MediaType(contentType) == "application/x-www-form-urlencoded"
+}
+
+func MediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	return mediaType
+}
@@ -0,0 +1,42 @@
+package signoz
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const overflowValue = "other"
+
+var cardinalityLimit int
+
+type limiter struct {
+	mu   sync.Mutex
+	seen map[attribute.Distinct]struct{}
+}
+
+func (l *limiter) limit(keyValue []KeyValue) attribute.Set {
+	set := attribute.NewSet(toAttributes(keyValue)...)
+	if cardinalityLimit <= 0 {
+		return set
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.seen == nil {
+		l.seen = map[attribute.Distinct]struct{}{}
+	}
+
+	if _, ok := l.seen[set.Equivalent()]; ok || len(l.seen) < cardinalityLimit {
+		l.seen[set.Equivalent()] = struct{}{}
+		return set
+	}
+
+	overflow := make([]attribute.KeyValue, 0, len(keyValue))
+	for _, item := range keyValue {
+		overflow = append(overflow, attribute.String(item.Key, overflowValue))
+	}
+
+	return attribute.NewSet(overflow...)
+}
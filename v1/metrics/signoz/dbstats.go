@@ -0,0 +1,71 @@
+package signoz
+
+import (
+	"context"
+	"database/sql"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	connections, err := meter.Int64ObservableUpDownCounter(
+		"db.client.connections.usage",
+		metric.WithDescription("Number of connections in the pool by state"),
+	)
+	if err != nil {
+		return err
+	}
+
+	maxOpen, err := meter.Int64ObservableUpDownCounter(
+		"db.client.connections.max",
+		metric.WithDescription("Maximum number of open connections allowed"),
+	)
+	if err != nil {
+		return err
+	}
+
+	waitCount, err := meter.Int64ObservableCounter(
+		"db.client.connections.wait_count",
+		metric.WithDescription("Total number of connections waited for"),
+	)
+	if err != nil {
+		return err
+	}
+
+	waitDuration, err := meter.Float64ObservableCounter(
+		"db.client.connections.wait_duration",
+		metric.WithUnit("ms"),
+		metric.WithDescription("Total time blocked waiting for a new connection in milliseconds"),
+	)
+	if err != nil {
+		return err
+	}
+
+	closed, err := meter.Int64ObservableCounter(
+		"db.client.connections.closed",
+		metric.WithDescription("Total number of connections closed by reason"),
+	)
+	if err != nil {
+		return err
+	}
+
+	nameAttribute := attribute.String("db.name", dbName)
+
+	_, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		stats := db.Stats()
+
+		observer.ObserveInt64(connections, int64(stats.Idle), metric.WithAttributes(nameAttribute, attribute.String("state", "idle")))
+		observer.ObserveInt64(connections, int64(stats.InUse), metric.WithAttributes(nameAttribute, attribute.String("state", "used")))
+		observer.ObserveInt64(maxOpen, int64(stats.MaxOpenConnections), metric.WithAttributes(nameAttribute))
+		observer.ObserveInt64(waitCount, stats.WaitCount, metric.WithAttributes(nameAttribute))
+		observer.ObserveFloat64(waitDuration, float64(stats.WaitDuration.Microseconds())/1000, metric.WithAttributes(nameAttribute))
+		observer.ObserveInt64(closed, stats.MaxIdleClosed, metric.WithAttributes(nameAttribute, attribute.String("reason", "max_idle")))
+		observer.ObserveInt64(closed, stats.MaxIdleTimeClosed, metric.WithAttributes(nameAttribute, attribute.String("reason", "max_idle_time")))
+		observer.ObserveInt64(closed, stats.MaxLifetimeClosed, metric.WithAttributes(nameAttribute, attribute.String("reason", "max_lifetime")))
+
+		return nil
+	}, connections, maxOpen, waitCount, waitDuration, closed)
+
+	return err
+}
@@ -0,0 +1,39 @@
+package signozecho
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/metrics/signoz"
+	"github.com/labstack/echo/v4"
+)
+
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			err := next(c)
+
+			statusCode := c.Response().Status
+			if err != nil {
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					statusCode = httpError.Code
+				} else if !c.Response().Committed {
+					statusCode = http.StatusInternalServerError
+				}
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = signoz.UnmatchedRoute
+			}
+
+			signoz.RecordHTTPRequest(c.Request().Context(), c.Request().Method, route, statusCode, time.Since(start))
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package signoz
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func RecordBusinessEvent(ctx context.Context, name string, value int64, keyValue []KeyValue) {
+	attributes := append([]KeyValue{{Key: "event.name", Value: name}}, keyValue...)
+	newCounter("business.event.count", "Number of business events recorded").Add(ctx, value, attributes)
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(name, trace.WithAttributes(append(toAttributes(keyValue), attribute.Int64("event.value", value))...))
+}
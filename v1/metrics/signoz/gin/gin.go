@@ -0,0 +1,23 @@
+package signozgin
+
+import (
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/metrics/signoz"
+	"github.com/gin-gonic/gin"
+)
+
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = signoz.UnmatchedRoute
+		}
+
+		signoz.RecordHTTPRequest(c.Request.Context(), c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,137 @@
+package signozgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/metrics/signoz"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type clientStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	desc   *grpc.StreamDesc
+	method string
+	start  time.Time
+	once   sync.Once
+	done   chan struct{}
+}
+
+func newClientStream(ctx context.Context, cs grpc.ClientStream, desc *grpc.StreamDesc, method string, start time.Time) *clientStream {
+	s := &clientStream{ClientStream: cs, ctx: ctx, desc: desc, method: method, start: start, done: make(chan struct{})}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.end(status.FromContextError(ctx.Err()).Err())
+		case <-s.done:
+		}
+	}()
+
+	return s
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		s.end(nil)
+	} else if err != nil {
+		s.end(err)
+	} else if !s.desc.ServerStreams {
+		s.end(nil)
+	}
+
+	return err
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil {
+		s.end(err)
+	}
+
+	return md, err
+}
+
+func (s *clientStream) end(err error) {
+	s.once.Do(func() {
+		record(s.ctx, "client", s.method, err, time.Since(s.start))
+		close(s.done)
+	})
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+		record(ctx, "server", info.FullMethod, err, time.Since(start))
+
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+		record(ss.Context(), "server", info.FullMethod, err, time.Since(start))
+
+		return err
+	}
+}
+
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		record(ctx, "client", method, err, time.Since(start))
+
+		return err
+	}
+}
+
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			record(ctx, "client", method, err, time.Since(start))
+			return cs, err
+		}
+
+		return newClientStream(ctx, cs, desc, method, start), nil
+	}
+}
+
+func record(ctx context.Context, kind, fullMethod string, err error, duration time.Duration) {
+	service, method := splitFullMethod(fullMethod)
+
+	attributes := []signoz.KeyValue{
+		{Key: "rpc.system", Value: "grpc"},
+		{Key: "rpc.service", Value: service},
+		{Key: "rpc.method", Value: method},
+		{Key: "rpc.grpc.status_code", Value: status.Code(err).String()},
+	}
+
+	signoz.RecordRPC(ctx, kind, attributes, err != nil, duration)
+}
+
+func splitFullMethod(fullMethod string) (string, string) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+
+	return "unknown", name
+}
@@ -0,0 +1,78 @@
+package signoz
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	"go.opentelemetry.io/contrib/instrumentation/host"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func startHostMetrics(provider metric.MeterProvider, diskPaths []string) error {
+	if err := host.Start(host.WithMeterProvider(provider)); err != nil {
+		return err
+	}
+
+	if len(diskPaths) == 0 {
+		diskPaths = []string{"/"}
+	}
+
+	hostMeter := provider.Meter("github.com/elraghifary/go-modules/v1/metrics/signoz/host")
+
+	usage, err := hostMeter.Int64ObservableUpDownCounter(
+		"system.disk.usage",
+		metric.WithUnit("By"),
+		metric.WithDescription("Disk space used and free per mount path"),
+	)
+	if err != nil {
+		return err
+	}
+
+	utilization, err := hostMeter.Float64ObservableGauge(
+		"system.disk.utilization",
+		metric.WithUnit("1"),
+		metric.WithDescription("Fraction of disk space used per mount path"),
+	)
+	if err != nil {
+		return err
+	}
+
+	io, err := hostMeter.Int64ObservableCounter(
+		"system.disk.io",
+		metric.WithUnit("By"),
+		metric.WithDescription("Bytes read from and written to disk devices"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = hostMeter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		for _, path := range diskPaths {
+			stat, err := disk.UsageWithContext(ctx, path)
+			if err != nil {
+				continue
+			}
+
+			pathAttribute := attribute.String("path", path)
+			observer.ObserveInt64(usage, int64(stat.Used), metric.WithAttributes(pathAttribute, attribute.String("state", "used")))
+			observer.ObserveInt64(usage, int64(stat.Free), metric.WithAttributes(pathAttribute, attribute.String("state", "free")))
+			observer.ObserveFloat64(utilization, stat.UsedPercent/100, metric.WithAttributes(pathAttribute))
+		}
+
+		counters, err := disk.IOCountersWithContext(ctx)
+		if err != nil {
+			return nil
+		}
+
+		for device, counter := range counters {
+			deviceAttribute := attribute.String("device", device)
+			observer.ObserveInt64(io, int64(counter.ReadBytes), metric.WithAttributes(deviceAttribute, attribute.String("direction", "read")))
+			observer.ObserveInt64(io, int64(counter.WriteBytes), metric.WithAttributes(deviceAttribute, attribute.String("direction", "write")))
+		}
+
+		return nil
+	}, usage, utilization, io)
+
+	return err
+}
@@ -0,0 +1,100 @@
+package signoz
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const UnmatchedRoute = "unmatched"
+
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not implement http.Hijacker")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && !w.wroteHeader {
+		w.statusCode = http.StatusSwitchingProtocols
+		w.wroteHeader = true
+	}
+
+	return conn, rw, err
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func HTTPMiddleware(h http.Handler, route func(*http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(rw, r)
+
+		matched := UnmatchedRoute
+		if route != nil {
+			if name := route(r); name != "" {
+				matched = name
+			}
+		}
+
+		RecordHTTPRequest(r.Context(), r.Method, matched, rw.statusCode, time.Since(start))
+	})
+}
+
+func RecordHTTPRequest(ctx context.Context, method, route string, statusCode int, duration time.Duration) {
+	attributes := []KeyValue{
+		{Key: "http.method", Value: method},
+		{Key: "http.route", Value: route},
+		{Key: "http.status_class", Value: statusClass(statusCode)},
+	}
+
+	newCounter("http.server.request.count", "Number of HTTP requests handled").Add(ctx, 1, attributes)
+	if statusCode >= http.StatusInternalServerError {
+		newCounter("http.server.error.count", "Number of HTTP requests that ended with a server error").Add(ctx, 1, attributes)
+	}
+	newHistogram("http.server.duration", "Duration of HTTP requests in milliseconds").Record(ctx, float64(duration.Microseconds())/1000, attributes)
+}
+
+func statusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return "unknown"
+	}
+
+	return strconv.Itoa(statusCode/100) + "xx"
+}
@@ -0,0 +1,164 @@
+package signoz
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	Counter interface {
+		Add(ctx context.Context, value int64, attributes []KeyValue)
+	}
+
+	UpDownCounter interface {
+		Add(ctx context.Context, value int64, attributes []KeyValue)
+	}
+
+	Histogram interface {
+		Record(ctx context.Context, value float64, attributes []KeyValue)
+	}
+
+	Gauge interface {
+		Set(ctx context.Context, value float64, attributes []KeyValue)
+	}
+
+	counter struct {
+		limiter
+		instrument metric.Int64Counter
+	}
+
+	upDownCounter struct {
+		limiter
+		instrument metric.Int64UpDownCounter
+	}
+
+	histogram struct {
+		limiter
+		instrument metric.Float64Histogram
+	}
+
+	gauge struct {
+		limiter
+		mu         sync.RWMutex
+		observable metric.Float64ObservableGauge
+		values     map[attribute.Distinct]gaugeValue
+	}
+
+	gaugeValue struct {
+		value float64
+		set   attribute.Set
+	}
+
+	noopInstrument struct{}
+)
+
+var (
+	counters       sync.Map
+	upDownCounters sync.Map
+	histograms     sync.Map
+	gauges         sync.Map
+)
+
+func newCounter(name, description string) Counter {
+	if instrument, ok := counters.Load(name); ok {
+		return instrument.(Counter)
+	}
+
+	instrument, err := meter.Int64Counter(name, metric.WithDescription(description))
+	if err != nil {
+		log.Printf("Failed to create counter %s: %v", name, err)
+		return noopInstrument{}
+	}
+
+	c, _ := counters.LoadOrStore(name, &counter{instrument: instrument})
+	return c.(Counter)
+}
+
+func newUpDownCounter(name, description string) UpDownCounter {
+	if instrument, ok := upDownCounters.Load(name); ok {
+		return instrument.(UpDownCounter)
+	}
+
+	instrument, err := meter.Int64UpDownCounter(name, metric.WithDescription(description))
+	if err != nil {
+		log.Printf("Failed to create up-down counter %s: %v", name, err)
+		return noopInstrument{}
+	}
+
+	c, _ := upDownCounters.LoadOrStore(name, &upDownCounter{instrument: instrument})
+	return c.(UpDownCounter)
+}
+
+func newHistogram(name, description string) Histogram {
+	if instrument, ok := histograms.Load(name); ok {
+		return instrument.(Histogram)
+	}
+
+	instrument, err := meter.Float64Histogram(name, metric.WithDescription(description))
+	if err != nil {
+		log.Printf("Failed to create histogram %s: %v", name, err)
+		return noopInstrument{}
+	}
+
+	h, _ := histograms.LoadOrStore(name, &histogram{instrument: instrument})
+	return h.(Histogram)
+}
+
+func newGauge(name, description string) Gauge {
+	if instrument, ok := gauges.Load(name); ok {
+		return instrument.(Gauge)
+	}
+
+	g := &gauge{values: map[attribute.Distinct]gaugeValue{}}
+
+	observable, err := meter.Float64ObservableGauge(name, metric.WithDescription(description), metric.WithFloat64Callback(g.observe))
+	if err != nil {
+		log.Printf("Failed to create gauge %s: %v", name, err)
+		return noopInstrument{}
+	}
+	g.observable = observable
+
+	instrument, _ := gauges.LoadOrStore(name, g)
+	return instrument.(Gauge)
+}
+
+func (c *counter) Add(ctx context.Context, value int64, keyValue []KeyValue) {
+	c.instrument.Add(ctx, value, metric.WithAttributeSet(c.limit(keyValue)))
+}
+
+func (c *upDownCounter) Add(ctx context.Context, value int64, keyValue []KeyValue) {
+	c.instrument.Add(ctx, value, metric.WithAttributeSet(c.limit(keyValue)))
+}
+
+func (h *histogram) Record(ctx context.Context, value float64, keyValue []KeyValue) {
+	h.instrument.Record(ctx, value, metric.WithAttributeSet(h.limit(keyValue)))
+}
+
+func (g *gauge) Set(_ context.Context, value float64, keyValue []KeyValue) {
+	set := g.limit(keyValue)
+
+	g.mu.Lock()
+	g.values[set.Equivalent()] = gaugeValue{value: value, set: set}
+	g.mu.Unlock()
+}
+
+func (g *gauge) observe(_ context.Context, observer metric.Float64Observer) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, v := range g.values {
+		observer.Observe(v.value, metric.WithAttributeSet(v.set))
+	}
+
+	return nil
+}
+
+func (noopInstrument) Add(context.Context, int64, []KeyValue) {}
+
+func (noopInstrument) Record(context.Context, float64, []KeyValue) {}
+
+func (noopInstrument) Set(context.Context, float64, []KeyValue) {}
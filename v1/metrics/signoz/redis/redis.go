@@ -0,0 +1,78 @@
+package signozredis
+
+import (
+	"context"
+
+	"github.com/elraghifary/go-modules/v1/metrics/signoz"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type PoolStatser interface {
+	PoolStats() *redis.PoolStats
+}
+
+func RegisterPoolStats(client PoolStatser, name string) error {
+	meter := signoz.Meter()
+
+	connections, err := meter.Int64ObservableUpDownCounter(
+		"redis.client.connections.usage",
+		metric.WithDescription("Number of connections in the pool by state"),
+	)
+	if err != nil {
+		return err
+	}
+
+	hits, err := meter.Int64ObservableCounter(
+		"redis.client.connections.hits",
+		metric.WithDescription("Number of times a free connection was found in the pool"),
+	)
+	if err != nil {
+		return err
+	}
+
+	misses, err := meter.Int64ObservableCounter(
+		"redis.client.connections.misses",
+		metric.WithDescription("Number of times a free connection was not found in the pool"),
+	)
+	if err != nil {
+		return err
+	}
+
+	timeouts, err := meter.Int64ObservableCounter(
+		"redis.client.connections.timeouts",
+		metric.WithDescription("Number of times a wait timeout occurred"),
+	)
+	if err != nil {
+		return err
+	}
+
+	stale, err := meter.Int64ObservableCounter(
+		"redis.client.connections.stale",
+		metric.WithDescription("Number of stale connections removed from the pool"),
+	)
+	if err != nil {
+		return err
+	}
+
+	nameAttribute := attribute.String("db.redis.client", name)
+
+	_, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		stats := client.PoolStats()
+		if stats == nil {
+			return nil
+		}
+
+		observer.ObserveInt64(connections, int64(stats.IdleConns), metric.WithAttributes(nameAttribute, attribute.String("state", "idle")))
+		observer.ObserveInt64(connections, int64(stats.TotalConns-stats.IdleConns), metric.WithAttributes(nameAttribute, attribute.String("state", "used")))
+		observer.ObserveInt64(hits, int64(stats.Hits), metric.WithAttributes(nameAttribute))
+		observer.ObserveInt64(misses, int64(stats.Misses), metric.WithAttributes(nameAttribute))
+		observer.ObserveInt64(timeouts, int64(stats.Timeouts), metric.WithAttributes(nameAttribute))
+		observer.ObserveInt64(stale, int64(stats.StaleConns), metric.WithAttributes(nameAttribute))
+
+		return nil
+	}, connections, hits, misses, timeouts, stale)
+
+	return err
+}
@@ -0,0 +1,14 @@
+package signoz
+
+import (
+	"context"
+	"time"
+)
+
+func RecordRPC(ctx context.Context, kind string, attributes []KeyValue, failed bool, duration time.Duration) {
+	newCounter("rpc."+kind+".request.count", "Number of RPCs handled").Add(ctx, 1, attributes)
+	if failed {
+		newCounter("rpc."+kind+".error.count", "Number of RPCs that ended with an error").Add(ctx, 1, attributes)
+	}
+	newHistogram("rpc."+kind+".duration", "Duration of RPCs in milliseconds").Record(ctx, float64(duration.Microseconds())/1000, attributes)
+}
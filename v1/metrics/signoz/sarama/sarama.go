@@ -0,0 +1,67 @@
+package signozsarama
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM/sarama"
+	"github.com/elraghifary/go-modules/v1/metrics/signoz"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func RegisterConsumerLag(client sarama.Client, groups ...string) error {
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		return err
+	}
+
+	meter := signoz.Meter()
+
+	lag, err := meter.Int64ObservableGauge(
+		"messaging.kafka.consumer.lag",
+		metric.WithDescription("Number of messages the consumer group is behind the latest offset"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		for _, group := range groups {
+			offsets, err := admin.ListConsumerGroupOffsets(group, nil)
+			if err != nil {
+				log.Printf("Failed to list offsets for consumer group %s: %v", group, err)
+				continue
+			}
+
+			for topic, partitions := range offsets.Blocks {
+				for partition, block := range partitions {
+					if block.Offset < 0 {
+						continue
+					}
+
+					latest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+					if err != nil {
+						log.Printf("Failed to get latest offset for %s/%d: %v", topic, partition, err)
+						continue
+					}
+
+					value := latest - block.Offset
+					if value < 0 {
+						value = 0
+					}
+
+					observer.ObserveInt64(lag, value, metric.WithAttributes(
+						attribute.String("messaging.kafka.consumer.group", group),
+						attribute.String("messaging.destination", topic),
+						attribute.Int64("messaging.kafka.partition", int64(partition)),
+					))
+				}
+			}
+		}
+
+		return nil
+	}, lag)
+
+	return err
+}
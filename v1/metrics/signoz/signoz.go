@@ -0,0 +1,219 @@
+package signoz
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"google.golang.org/grpc/credentials"
+)
+
+type (
+	signoz struct {
+		serviceName  string
+		collectorURL string
+		insecure     string
+		protocol     string
+		interval     time.Duration
+		prometheus   bool
+		registry     *prometheus.Registry
+		hostMetrics  bool
+		diskPaths    []string
+		views        []View
+	}
+
+	Config struct {
+		ServiceName      string
+		CollectorURL     string
+		Insecure         string
+		Protocol         string
+		Interval         time.Duration
+		Prometheus       bool
+		HostMetrics      bool
+		DiskPaths        []string
+		Views            []View
+		CardinalityLimit int
+	}
+
+	KeyValue struct {
+		Key   string
+		Value string
+	}
+
+	Itf interface {
+		InitMeter() func(context.Context) error
+		MetricsHandler() http.Handler
+		Counter(name, description string) Counter
+		UpDownCounter(name, description string) UpDownCounter
+		Histogram(name, description string) Histogram
+		Gauge(name, description string) Gauge
+		AddCounter(ctx context.Context, name string, value int64, attributes []KeyValue)
+		AddUpDownCounter(ctx context.Context, name string, value int64, attributes []KeyValue)
+		RecordHistogram(ctx context.Context, name string, value float64, attributes []KeyValue)
+		SetGauge(ctx context.Context, name string, value float64, attributes []KeyValue)
+		RecordBusinessEvent(ctx context.Context, name string, value int64, attributes []KeyValue)
+	}
+)
+
+var meter metric.Meter
+
+func New(cfg Config) Itf {
+	meter = otel.Meter(cfg.ServiceName)
+	cardinalityLimit = cfg.CardinalityLimit
+
+	return &signoz{
+		serviceName:  cfg.ServiceName,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+		interval:     cfg.Interval,
+		prometheus:   cfg.Prometheus,
+		registry:     prometheus.NewRegistry(),
+		hostMetrics:  cfg.HostMetrics,
+		diskPaths:    cfg.DiskPaths,
+		views:        cfg.Views,
+	}
+}
+
+func Meter() metric.Meter {
+	return meter
+}
+
+func (s *signoz) InitMeter() func(context.Context) error {
+	var options []sdkmetric.Option
+
+	if s.collectorURL != "" {
+		exporter, err := s.newExporter()
+		if err != nil {
+			log.Fatalf("Failed to create exporter: %v", err)
+		}
+
+		var readerOptions []sdkmetric.PeriodicReaderOption
+		if s.interval > 0 {
+			readerOptions = append(readerOptions, sdkmetric.WithInterval(s.interval))
+		}
+		options = append(options, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, readerOptions...)))
+	}
+
+	if s.prometheus {
+		exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(s.registry))
+		if err != nil {
+			log.Fatalf("Failed to create prometheus exporter: %v", err)
+		}
+		options = append(options, sdkmetric.WithReader(exporter))
+	}
+
+	if len(s.views) > 0 {
+		options = append(options, sdkmetric.WithView(newViews(s.views)...))
+	}
+
+	resources, err := resource.New(
+		context.Background(),
+		resource.WithAttributes(
+			attribute.String("service.name", s.serviceName),
+			attribute.String("library.language", "go"),
+		),
+	)
+	if err != nil {
+		log.Fatalf("Could not set resources: %v", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(append(options, sdkmetric.WithResource(resources), sdkmetric.WithExemplarFilter(exemplar.TraceBasedFilter))...)
+	otel.SetMeterProvider(provider)
+
+	if s.hostMetrics {
+		if err := startHostMetrics(provider, s.diskPaths); err != nil {
+			log.Printf("Failed to start host metrics: %v", err)
+		}
+	}
+
+	return provider.Shutdown
+}
+
+func (s *signoz) MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
+
+func (s *signoz) newExporter() (sdkmetric.Exporter, error) {
+	secure := strings.ToLower(s.insecure) == "false" || s.insecure == "0" || strings.ToLower(s.insecure) == "f"
+
+	if strings.ToLower(s.protocol) == "http" {
+		options := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(s.collectorURL)}
+		if !secure {
+			options = append(options, otlpmetrichttp.WithInsecure())
+		}
+
+		return otlpmetrichttp.New(context.Background(), options...)
+	}
+
+	var secureOption otlpmetricgrpc.Option
+	if secure {
+		secureOption = otlpmetricgrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	} else {
+		secureOption = otlpmetricgrpc.WithInsecure()
+	}
+
+	return otlpmetricgrpc.New(
+		context.Background(),
+		secureOption,
+		otlpmetricgrpc.WithEndpoint(s.collectorURL),
+	)
+}
+
+func (s *signoz) Counter(name, description string) Counter {
+	return newCounter(name, description)
+}
+
+func (s *signoz) UpDownCounter(name, description string) UpDownCounter {
+	return newUpDownCounter(name, description)
+}
+
+func (s *signoz) Histogram(name, description string) Histogram {
+	return newHistogram(name, description)
+}
+
+func (s *signoz) Gauge(name, description string) Gauge {
+	return newGauge(name, description)
+}
+
+func (s *signoz) AddCounter(ctx context.Context, name string, value int64, keyValue []KeyValue) {
+	s.Counter(name, "").Add(ctx, value, keyValue)
+}
+
+func (s *signoz) AddUpDownCounter(ctx context.Context, name string, value int64, keyValue []KeyValue) {
+	s.UpDownCounter(name, "").Add(ctx, value, keyValue)
+}
+
+func (s *signoz) RecordHistogram(ctx context.Context, name string, value float64, keyValue []KeyValue) {
+	s.Histogram(name, "").Record(ctx, value, keyValue)
+}
+
+func (s *signoz) SetGauge(ctx context.Context, name string, value float64, keyValue []KeyValue) {
+	s.Gauge(name, "").Set(ctx, value, keyValue)
+}
+
+func (s *signoz) RecordBusinessEvent(ctx context.Context, name string, value int64, keyValue []KeyValue) {
+	RecordBusinessEvent(ctx, name, value, keyValue)
+}
+
+func toAttributes(keyValue []KeyValue) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(keyValue))
+	for _, item := range keyValue {
+		attributes = append(attributes, attribute.String(item.Key, item.Value))
+	}
+
+	return attributes
+}
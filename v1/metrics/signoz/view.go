@@ -0,0 +1,41 @@
+package signoz
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type View struct {
+	Instrument    string
+	Rename        string
+	Description   string
+	Buckets       []float64
+	AttributeKeys []string
+}
+
+func newViews(views []View) []sdkmetric.View {
+	result := make([]sdkmetric.View, 0, len(views))
+
+	for _, v := range views {
+		stream := sdkmetric.Stream{
+			Name:        v.Rename,
+			Description: v.Description,
+		}
+
+		if len(v.Buckets) > 0 {
+			stream.Aggregation = sdkmetric.AggregationExplicitBucketHistogram{Boundaries: v.Buckets}
+		}
+
+		if len(v.AttributeKeys) > 0 {
+			keys := make([]attribute.Key, 0, len(v.AttributeKeys))
+			for _, key := range v.AttributeKeys {
+				keys = append(keys, attribute.Key(key))
+			}
+			stream.AttributeFilter = attribute.NewAllowKeysFilter(keys...)
+		}
+
+		result = append(result, sdkmetric.NewView(sdkmetric.Instrument{Name: v.Instrument}, stream))
+	}
+
+	return result
+}
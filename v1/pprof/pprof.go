@@ -0,0 +1,130 @@
+package pprof
+
+import (
+	"crypto/subtle"
+	"log"
+	"net"
+	"net/http"
+	httppprof "net/http/pprof"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	pprof struct {
+		token      string
+		allowedIPs []*net.IPNet
+	}
+
+	Config struct {
+		Token      string
+		AllowedIPs []string
+	}
+
+	Itf interface {
+		Handler() http.Handler
+		ListenAndServe(addr string) error
+	}
+)
+
+func New(cfg Config) Itf {
+	p := &pprof{token: cfg.Token}
+
+	for _, value := range cfg.AllowedIPs {
+		if !strings.Contains(value, "/") {
+			if strings.Contains(value, ":") {
+				value += "/128"
+			} else {
+				value += "/32"
+			}
+		}
+
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			log.Printf("Invalid pprof allowed IP %s: %v", value, err)
+			continue
+		}
+		p.allowedIPs = append(p.allowedIPs, network)
+	}
+
+	return p
+}
+
+func (p *pprof) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", httppprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+
+	return p.authorize(mux)
+}
+
+func (p *pprof) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, p.Handler())
+}
+
+func (p *pprof) authorize(h http.Handler) http.Handler {
+	tracer := otel.Tracer("github.com/elraghifary/go-modules/v1/pprof")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(
+			r.Context(),
+			"pprof "+r.URL.Path,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("", r.URL.Path, r)...),
+		)
+		defer span.End()
+
+		if !p.allowed(r) {
+			span.SetAttributes(attribute.Bool("pprof.authorized", false))
+			span.SetStatus(codes.Error, http.StatusText(http.StatusForbidden))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		span.SetAttributes(attribute.Bool("pprof.authorized", true))
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (p *pprof) allowed(r *http.Request) bool {
+	if p.token == "" && len(p.allowedIPs) == 0 {
+		return false
+	}
+
+	if p.token != "" {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(p.token)) != 1 {
+			return false
+		}
+	}
+
+	if len(p.allowedIPs) > 0 {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return false
+		}
+
+		for _, network := range p.allowedIPs {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
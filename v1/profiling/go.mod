@@ -0,0 +1,14 @@
+module github.com/elraghifary/go-modules/v1/profiling
+
+go 1.18
+
+require (
+	github.com/grafana/pyroscope-go v1.1.2
+	go.opentelemetry.io/otel/trace v1.19.0
+)
+
+require (
+	github.com/grafana/pyroscope-go/godeltaprof v0.1.8 // indirect
+	github.com/klauspost/compress v1.17.8 // indirect
+	go.opentelemetry.io/otel v1.19.0 // indirect
+)
@@ -0,0 +1,93 @@
+package profiling
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"runtime/pprof"
+
+	"github.com/grafana/pyroscope-go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	profiling struct {
+		serviceName   string
+		serverAddress string
+		authToken     string
+		tenantID      string
+		tags          map[string]string
+		heap          bool
+	}
+
+	Config struct {
+		ServiceName   string
+		ServerAddress string
+		AuthToken     string
+		TenantID      string
+		Tags          map[string]string
+		Heap          bool
+	}
+
+	Itf interface {
+		InitProfiler() func() error
+	}
+)
+
+func New(cfg Config) Itf {
+	return &profiling{
+		serviceName:   cfg.ServiceName,
+		serverAddress: cfg.ServerAddress,
+		authToken:     cfg.AuthToken,
+		tenantID:      cfg.TenantID,
+		tags:          cfg.Tags,
+		heap:          cfg.Heap,
+	}
+}
+
+func (p *profiling) InitProfiler() func() error {
+	profileTypes := []pyroscope.ProfileType{pyroscope.ProfileCPU}
+	if p.heap {
+		profileTypes = append(profileTypes,
+			pyroscope.ProfileAllocObjects,
+			pyroscope.ProfileAllocSpace,
+			pyroscope.ProfileInuseObjects,
+			pyroscope.ProfileInuseSpace,
+		)
+	}
+
+	profiler, err := pyroscope.Start(pyroscope.Config{
+		ApplicationName: p.serviceName,
+		ServerAddress:   p.serverAddress,
+		AuthToken:       p.authToken,
+		TenantID:        p.tenantID,
+		Tags:            p.tags,
+		ProfileTypes:    profileTypes,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start profiler: %v", err)
+	}
+
+	return profiler.Stop
+}
+
+func Do(ctx context.Context, fn func(ctx context.Context)) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		fn(ctx)
+		return
+	}
+
+	pprof.Do(ctx, pprof.Labels(
+		"trace_id", spanContext.TraceID().String(),
+		"span_id", spanContext.SpanID().String(),
+	), fn)
+}
+
+func HTTPMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Do(r.Context(), func(ctx context.Context) {
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+}
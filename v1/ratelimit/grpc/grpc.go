@@ -0,0 +1,97 @@
+package ratelimitgrpc
+
+import (
+	"context"
+	"log"
+	"net"
+
+	"github.com/elraghifary/go-modules/v1/ratelimit"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type Option interface {
+	apply(config) config
+}
+
+type config struct {
+	KeyFunc func(ctx context.Context, fullMethod string) string
+}
+
+type option func(config) config
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithKeyFunc(keyFunc func(ctx context.Context, fullMethod string) string) Option {
+	return option(func(config config) config {
+		config.KeyFunc = keyFunc
+		return config
+	})
+}
+
+func UnaryServerInterceptor(limiter ratelimit.Limiter, opts ...Option) grpc.UnaryServerInterceptor {
+	config := newConfig(opts)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := config.allow(ctx, limiter, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(limiter ratelimit.Limiter, opts ...Option) grpc.StreamServerInterceptor {
+	config := newConfig(opts)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := config.allow(ss.Context(), limiter, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func newConfig(opts []Option) config {
+	config := config{KeyFunc: peerAddress}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	return config
+}
+
+func (c config) allow(ctx context.Context, limiter ratelimit.Limiter, fullMethod string) error {
+	allowed, err := limiter.Allow(ctx, c.KeyFunc(ctx, fullMethod))
+	if err != nil {
+		log.Printf("Rate limiter failed, allowing request: %v", err)
+		return nil
+	}
+
+	if !allowed {
+		trace.SpanFromContext(ctx).SetAttributes(ratelimit.RateLimitedKey.Bool(true))
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	return nil
+}
+
+func peerAddress(ctx context.Context, fullMethod string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"log"
+	"net"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const RateLimitedKey = attribute.Key("ratelimited")
+
+type Option interface {
+	apply(config) config
+}
+
+type config struct {
+	KeyFunc func(r *http.Request) string
+}
+
+type option func(config) config
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithKeyFunc(keyFunc func(r *http.Request) string) Option {
+	return option(func(config config) config {
+		config.KeyFunc = keyFunc
+		return config
+	})
+}
+
+func HTTPMiddleware(limiter Limiter, opts ...Option) func(http.Handler) http.Handler {
+	config := config{KeyFunc: clientIP}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed, err := limiter.Allow(r.Context(), config.KeyFunc(r))
+			if err != nil {
+				log.Printf("Rate limiter failed, allowing request: %v", err)
+				allowed = true
+			}
+
+			if !allowed {
+				trace.SpanFromContext(r.Context()).SetAttributes(RateLimitedKey.Bool(true))
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Limiter interface {
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+const pruneThreshold = 10000
+
+type (
+	tokenBucket struct {
+		mu      sync.Mutex
+		rate    float64
+		burst   float64
+		buckets map[string]*bucket
+	}
+
+	bucket struct {
+		tokens float64
+		last   time.Time
+	}
+)
+
+func NewTokenBucket(rate float64, burst int) Limiter {
+	return &tokenBucket{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: map[string]*bucket{},
+	}
+}
+
+func (l *tokenBucket) Allow(ctx context.Context, key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= pruneThreshold {
+			l.prune(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false, nil
+	}
+	b.tokens--
+
+	return true, nil
+}
+
+func (l *tokenBucket) prune(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+type (
+	slidingWindow struct {
+		mu      sync.Mutex
+		limit   int
+		window  time.Duration
+		windows map[string]*window
+	}
+
+	window struct {
+		start    time.Time
+		current  int
+		previous int
+	}
+)
+
+func NewSlidingWindow(limit int, size time.Duration) Limiter {
+	return &slidingWindow{
+		limit:   limit,
+		window:  size,
+		windows: map[string]*window{},
+	}
+}
+
+func (l *slidingWindow) Allow(ctx context.Context, key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	w, ok := l.windows[key]
+	if !ok {
+		if len(l.windows) >= pruneThreshold {
+			l.prune(now)
+		}
+		w = &window{start: now.Truncate(l.window)}
+		l.windows[key] = w
+	}
+
+	if elapsed := now.Sub(w.start); elapsed >= l.window {
+		if elapsed >= 2*l.window {
+			w.previous = 0
+		} else {
+			w.previous = w.current
+		}
+		w.current = 0
+		w.start = now.Truncate(l.window)
+	}
+
+	weight := 1 - float64(now.Sub(w.start))/float64(l.window)
+	if float64(w.previous)*weight+float64(w.current) >= float64(l.limit) {
+		return false, nil
+	}
+	w.current++
+
+	return true, nil
+}
+
+func (l *slidingWindow) prune(now time.Time) {
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= 2*l.window {
+			delete(l.windows, key)
+		}
+	}
+}
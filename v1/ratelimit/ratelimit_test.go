@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type step struct {
+	advance time.Duration
+	want    bool
+}
+
+func TestTokenBucket(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+		steps []step
+	}{
+		{"burst then reject", 1, 2, []step{{0, true}, {0, true}, {0, false}}},
+		{"zero burst", 1, 0, []step{{0, false}, {time.Hour, false}}},
+		{"refill one token", 10, 1, []step{{0, true}, {0, false}, {100 * time.Millisecond, true}, {0, false}}},
+		{"refill capped at burst", 100, 2, []step{{0, true}, {0, true}, {time.Hour, true}, {0, true}, {0, false}}},
+		{"no refill at zero rate", 0, 1, []step{{0, true}, {time.Hour, false}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewTokenBucket(tt.rate, tt.burst).(*tokenBucket)
+
+			for i, s := range tt.steps {
+				if b, ok := l.buckets["key"]; ok {
+					b.last = b.last.Add(-s.advance)
+				}
+
+				got, err := l.Allow(context.Background(), "key")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != s.want {
+					t.Errorf("step %d: Allow = %v, want %v", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenBucketKeys(t *testing.T) {
+	l := NewTokenBucket(0, 1)
+	ctx := context.Background()
+
+	for _, key := range []string{"a", "b"} {
+		if ok, _ := l.Allow(ctx, key); !ok {
+			t.Errorf("first Allow(%q) rejected", key)
+		}
+		if ok, _ := l.Allow(ctx, key); ok {
+			t.Errorf("second Allow(%q) allowed", key)
+		}
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	const size = time.Minute
+
+	tests := []struct {
+		name   string
+		limit  int
+		window *window
+		want   []bool
+	}{
+		{"within limit", 2, nil, []bool{true, true, false}},
+		{"zero limit", 0, nil, []bool{false}},
+		{"weighted previous window", 2, &window{start: time.Now().Add(-size / 4), previous: 2}, []bool{true, false}},
+		{"previous window mostly elapsed", 2, &window{start: time.Now().Add(-size * 3 / 4), previous: 2}, []bool{true, true, false}},
+		{"stale window reset", 2, &window{start: time.Now().Add(-3 * size), current: 2, previous: 2}, []bool{true, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSlidingWindow(tt.limit, size).(*slidingWindow)
+			if tt.window != nil {
+				l.windows["key"] = tt.window
+			}
+
+			for i, want := range tt.want {
+				got, err := l.Allow(context.Background(), "key")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Errorf("call %d: Allow = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
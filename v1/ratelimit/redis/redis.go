@@ -0,0 +1,60 @@
+package ratelimitredis
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/ratelimit"
+	"github.com/redis/go-redis/v9"
+)
+
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+redis.call("ZREMRANGEBYSCORE", key, "-inf", now - window)
+if redis.call("ZCARD", key) >= limit then
+	return 0
+end
+
+redis.call("ZADD", key, now, now .. "-" .. ARGV[3])
+redis.call("PEXPIRE", key, window)
+return 1
+`)
+
+type slidingWindow struct {
+	client redis.Scripter
+	prefix string
+	limit  int
+	window time.Duration
+}
+
+func NewSlidingWindow(client redis.Scripter, prefix string, limit int, window time.Duration) ratelimit.Limiter {
+	return &slidingWindow{
+		client: client,
+		prefix: prefix,
+		limit:  limit,
+		window: window,
+	}
+}
+
+func (l *slidingWindow) Allow(ctx context.Context, key string) (bool, error) {
+	allowed, err := slidingWindowScript.Run(
+		ctx,
+		l.client,
+		[]string{l.prefix + key},
+		l.window.Milliseconds(),
+		l.limit,
+		strconv.FormatInt(rand.Int63(), 36),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return allowed == 1, nil
+}
@@ -0,0 +1,284 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type State = otel.BreakerState
+
+const (
+	StateClosed   = otel.BreakerClosed
+	StateOpen     = otel.BreakerOpen
+	StateHalfOpen = otel.BreakerHalfOpen
+)
+
+var (
+	ErrOpenState       = errors.New("circuit breaker is open")
+	ErrTooManyRequests = errors.New("too many requests in half-open state")
+)
+
+type (
+	Counts struct {
+		Requests             uint32
+		TotalSuccesses       uint32
+		TotalFailures        uint32
+		ConsecutiveSuccesses uint32
+		ConsecutiveFailures  uint32
+	}
+
+	Config struct {
+		Name          string
+		MaxRequests   uint32
+		Interval      time.Duration
+		Timeout       time.Duration
+		ReadyToTrip   func(counts Counts) bool
+		IsSuccessful  func(err error) bool
+		OnStateChange func(ctx context.Context, name string, from, to State)
+	}
+
+	breaker struct {
+		name          string
+		maxRequests   uint32
+		interval      time.Duration
+		timeout       time.Duration
+		readyToTrip   func(counts Counts) bool
+		isSuccessful  func(err error) bool
+		onStateChange func(ctx context.Context, name string, from, to State)
+		transitions   metric.Int64Counter
+
+		mu         sync.Mutex
+		state      State
+		generation uint64
+		counts     Counts
+		expiry     time.Time
+	}
+
+	Itf interface {
+		Name() string
+		State() State
+		Counts() Counts
+		Execute(ctx context.Context, fn func(ctx context.Context) error) error
+		Allow(ctx context.Context) (done func(success bool), err error)
+	}
+)
+
+const (
+	defaultTimeout             = 60 * time.Second
+	defaultConsecutiveFailures = 5
+)
+
+func New(cfg Config) Itf {
+	b := &breaker{
+		name:          cfg.Name,
+		maxRequests:   cfg.MaxRequests,
+		interval:      cfg.Interval,
+		timeout:       cfg.Timeout,
+		readyToTrip:   cfg.ReadyToTrip,
+		isSuccessful:  cfg.IsSuccessful,
+		onStateChange: cfg.OnStateChange,
+		state:         StateClosed,
+	}
+	if b.maxRequests == 0 {
+		b.maxRequests = 1
+	}
+	if b.timeout <= 0 {
+		b.timeout = defaultTimeout
+	}
+	if b.readyToTrip == nil {
+		b.readyToTrip = func(counts Counts) bool {
+			return counts.ConsecutiveFailures >= defaultConsecutiveFailures
+		}
+	}
+	if b.isSuccessful == nil {
+		b.isSuccessful = func(err error) bool {
+			return err == nil
+		}
+	}
+
+	counter, err := otelapi.Meter("github.com/elraghifary/go-modules/v1/resilience/circuitbreaker").Int64Counter(
+		"circuit_breaker.state_change",
+		metric.WithDescription("Number of circuit breaker state transitions"),
+	)
+	if err != nil {
+		log.Printf("Failed to create circuit breaker counter: %v", err)
+	}
+	b.transitions = counter
+
+	b.mu.Lock()
+	b.newGeneration(time.Now())
+	b.mu.Unlock()
+
+	return b
+}
+
+func (b *breaker) Name() string {
+	return b.name
+}
+
+func (b *breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, _ := b.currentState(context.Background(), time.Now())
+	return state
+}
+
+func (b *breaker) Counts() Counts {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.counts
+}
+
+func (b *breaker) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	generation, err := b.beforeRequest(ctx)
+	if err != nil {
+		otel.RecordBreakerRejection(ctx, b.name, b.State(), err)
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.afterRequest(ctx, generation, false)
+			panic(r)
+		}
+	}()
+
+	err = fn(ctx)
+	b.afterRequest(ctx, generation, b.isSuccessful(err))
+
+	return err
+}
+
+func (b *breaker) Allow(ctx context.Context) (func(success bool), error) {
+	generation, err := b.beforeRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(success bool) {
+		b.afterRequest(ctx, generation, success)
+	}, nil
+}
+
+func (b *breaker) beforeRequest(ctx context.Context) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, generation := b.currentState(ctx, time.Now())
+	if state == StateOpen {
+		return generation, ErrOpenState
+	}
+	if state == StateHalfOpen && b.counts.Requests >= b.maxRequests {
+		return generation, ErrTooManyRequests
+	}
+
+	b.counts.Requests++
+	return generation, nil
+}
+
+func (b *breaker) afterRequest(ctx context.Context, before uint64, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	state, generation := b.currentState(ctx, now)
+	if generation != before {
+		return
+	}
+
+	if success {
+		b.onSuccess(ctx, state, now)
+	} else {
+		b.onFailure(ctx, state, now)
+	}
+}
+
+func (b *breaker) onSuccess(ctx context.Context, state State, now time.Time) {
+	b.counts.TotalSuccesses++
+	b.counts.ConsecutiveSuccesses++
+	b.counts.ConsecutiveFailures = 0
+
+	if state == StateHalfOpen && b.counts.ConsecutiveSuccesses >= b.maxRequests {
+		b.setState(ctx, StateClosed, now)
+	}
+}
+
+func (b *breaker) onFailure(ctx context.Context, state State, now time.Time) {
+	b.counts.TotalFailures++
+	b.counts.ConsecutiveFailures++
+	b.counts.ConsecutiveSuccesses = 0
+
+	switch state {
+	case StateClosed:
+		if b.readyToTrip(b.counts) {
+			b.setState(ctx, StateOpen, now)
+		}
+	case StateHalfOpen:
+		b.setState(ctx, StateOpen, now)
+	}
+}
+
+func (b *breaker) currentState(ctx context.Context, now time.Time) (State, uint64) {
+	switch b.state {
+	case StateClosed:
+		if !b.expiry.IsZero() && b.expiry.Before(now) {
+			b.newGeneration(now)
+		}
+	case StateOpen:
+		if b.expiry.Before(now) {
+			b.setState(ctx, StateHalfOpen, now)
+		}
+	}
+
+	return b.state, b.generation
+}
+
+func (b *breaker) setState(ctx context.Context, state State, now time.Time) {
+	if b.state == state {
+		return
+	}
+
+	from := b.state
+	b.state = state
+	b.newGeneration(now)
+
+	otel.RecordBreakerState(ctx, b.name, from, state)
+	if b.transitions != nil {
+		b.transitions.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("circuit_breaker.name", b.name),
+			attribute.String("circuit_breaker.from", string(from)),
+			attribute.String("circuit_breaker.to", string(state)),
+		))
+	}
+	if b.onStateChange != nil {
+		b.onStateChange(ctx, b.name, from, state)
+	}
+}
+
+func (b *breaker) newGeneration(now time.Time) {
+	b.generation++
+	b.counts = Counts{}
+
+	switch b.state {
+	case StateClosed:
+		if b.interval > 0 {
+			b.expiry = now.Add(b.interval)
+		} else {
+			b.expiry = time.Time{}
+		}
+	case StateOpen:
+		b.expiry = now.Add(b.timeout)
+	default:
+		b.expiry = time.Time{}
+	}
+}
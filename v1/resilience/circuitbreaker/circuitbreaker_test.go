@@ -0,0 +1,115 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errFailed = errors.New("failed")
+
+func TestBreakerStateMachine(t *testing.T) {
+	const (
+		succeed = "succeed"
+		fail    = "fail"
+		expire  = "expire"
+	)
+
+	tests := []struct {
+		name  string
+		cfg   Config
+		steps []string
+		want  []State
+	}{
+		{"stays closed on success", Config{}, []string{succeed, succeed}, []State{StateClosed, StateClosed}},
+		{"trips after consecutive failures", Config{}, []string{fail, fail, fail, fail, fail}, []State{StateClosed, StateClosed, StateClosed, StateClosed, StateOpen}},
+		{"success resets consecutive failures", Config{}, []string{fail, fail, fail, fail, succeed, fail}, []State{StateClosed, StateClosed, StateClosed, StateClosed, StateClosed, StateClosed}},
+		{"custom ready to trip", Config{ReadyToTrip: func(c Counts) bool { return c.TotalFailures >= 2 }}, []string{fail, fail}, []State{StateClosed, StateOpen}},
+		{"half open after timeout", Config{ReadyToTrip: tripOnFirst}, []string{fail, expire}, []State{StateOpen, StateHalfOpen}},
+		{"half open closes on success", Config{ReadyToTrip: tripOnFirst}, []string{fail, expire, succeed}, []State{StateOpen, StateHalfOpen, StateClosed}},
+		{"half open reopens on failure", Config{ReadyToTrip: tripOnFirst}, []string{fail, expire, fail}, []State{StateOpen, StateHalfOpen, StateOpen}},
+		{"half open needs max requests", Config{ReadyToTrip: tripOnFirst, MaxRequests: 2}, []string{fail, expire, succeed, succeed}, []State{StateOpen, StateHalfOpen, StateHalfOpen, StateClosed}},
+		{"interval clears counts", Config{ReadyToTrip: func(c Counts) bool { return c.TotalFailures >= 2 }, Interval: time.Hour}, []string{fail, expire, fail}, []State{StateClosed, StateClosed, StateClosed}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(tt.cfg).(*breaker)
+
+			for i, s := range tt.steps {
+				switch s {
+				case succeed:
+					_ = b.Execute(context.Background(), func(context.Context) error { return nil })
+				case fail:
+					_ = b.Execute(context.Background(), func(context.Context) error { return errFailed })
+				case expire:
+					b.mu.Lock()
+					b.expiry = time.Now().Add(-time.Second)
+					b.mu.Unlock()
+				}
+
+				if got := b.State(); got != tt.want[i] {
+					t.Errorf("step %d (%s): State = %s, want %s", i, s, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerRejections(t *testing.T) {
+	ctx := context.Background()
+	b := New(Config{ReadyToTrip: tripOnFirst}).(*breaker)
+
+	_ = b.Execute(ctx, func(context.Context) error { return errFailed })
+	if err := b.Execute(ctx, func(context.Context) error { return nil }); !errors.Is(err, ErrOpenState) {
+		t.Fatalf("Execute while open = %v, want %v", err, ErrOpenState)
+	}
+
+	b.mu.Lock()
+	b.expiry = time.Now().Add(-time.Second)
+	b.mu.Unlock()
+
+	done, err := b.Allow(ctx)
+	if err != nil {
+		t.Fatalf("first Allow while half open = %v", err)
+	}
+	if _, err := b.Allow(ctx); !errors.Is(err, ErrTooManyRequests) {
+		t.Fatalf("second Allow while half open = %v, want %v", err, ErrTooManyRequests)
+	}
+
+	done(true)
+	if got := b.State(); got != StateClosed {
+		t.Errorf("State after successful probe = %s, want %s", got, StateClosed)
+	}
+}
+
+func TestBreakerOnStateChange(t *testing.T) {
+	var transitions []State
+	b := New(Config{
+		ReadyToTrip: tripOnFirst,
+		OnStateChange: func(_ context.Context, _ string, _, to State) {
+			transitions = append(transitions, to)
+		},
+	}).(*breaker)
+
+	_ = b.Execute(context.Background(), func(context.Context) error { return errFailed })
+	b.mu.Lock()
+	b.expiry = time.Now().Add(-time.Second)
+	b.mu.Unlock()
+	_ = b.Execute(context.Background(), func(context.Context) error { return nil })
+
+	want := []State{StateOpen, StateHalfOpen, StateClosed}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transition %d = %s, want %s", i, transitions[i], want[i])
+		}
+	}
+}
+
+func tripOnFirst(counts Counts) bool {
+	return counts.ConsecutiveFailures >= 1
+}
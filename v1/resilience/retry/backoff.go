@@ -0,0 +1,44 @@
+package retry
+
+import (
+	"math/rand"
+	"time"
+)
+
+type Backoff interface {
+	Next(attempt int) time.Duration
+}
+
+type backoffFunc func(attempt int) time.Duration
+
+func (fn backoffFunc) Next(attempt int) time.Duration {
+	return fn(attempt)
+}
+
+func Constant(delay time.Duration) Backoff {
+	return backoffFunc(func(int) time.Duration {
+		return delay
+	})
+}
+
+func Exponential(base, max time.Duration) Backoff {
+	return backoffFunc(func(attempt int) time.Duration {
+		delay := base << uint(attempt-1)
+		if delay <= 0 || (max > 0 && delay > max) {
+			return max
+		}
+
+		return delay
+	})
+}
+
+func Jittered(backoff Backoff) Backoff {
+	return backoffFunc(func(attempt int) time.Duration {
+		delay := backoff.Next(attempt)
+		if delay <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(delay) + 1))
+	})
+}
@@ -0,0 +1,41 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		attempt int
+		want    time.Duration
+	}{
+		{"constant", Constant(50 * time.Millisecond), 3, 50 * time.Millisecond},
+		{"exponential first", Exponential(100*time.Millisecond, time.Second), 1, 100 * time.Millisecond},
+		{"exponential grows", Exponential(100*time.Millisecond, time.Second), 3, 400 * time.Millisecond},
+		{"exponential capped", Exponential(100*time.Millisecond, time.Second), 5, time.Second},
+		{"exponential uncapped", Exponential(100*time.Millisecond, 0), 5, 1600 * time.Millisecond},
+		{"exponential overflow", Exponential(time.Second, time.Minute), 64, time.Minute},
+		{"jittered zero", Jittered(Constant(0)), 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.backoff.Next(tt.attempt); got != tt.want {
+				t.Errorf("Next(%d) = %s, want %s", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJitteredBounds(t *testing.T) {
+	backoff := Jittered(Constant(10 * time.Millisecond))
+
+	for i := 0; i < 100; i++ {
+		if got := backoff.Next(1); got < 0 || got > 10*time.Millisecond {
+			t.Fatalf("Next(1) = %s, want within [0, 10ms]", got)
+		}
+	}
+}
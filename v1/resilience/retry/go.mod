@@ -0,0 +1,8 @@
+module github.com/elraghifary/go-modules/v1/resilience/retry
+
+go 1.18
+
+require (
+	go.opentelemetry.io/otel v1.19.0
+	go.opentelemetry.io/otel/trace v1.19.0
+)
@@ -0,0 +1,126 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Policy struct {
+	MaxAttempts int
+	Backoff     Backoff
+	RetryOn     func(err error) bool
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+func RetryOnErrors(targets ...error) func(err error) bool {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func Retry(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	_, err := Do(ctx, policy, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+
+	return err
+}
+
+func Do[T any](ctx context.Context, policy Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	span := trace.SpanFromContext(ctx)
+
+	var (
+		result T
+		err    error
+	)
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		result, err = fn(ctx)
+		if err == nil {
+			if attempt > 1 {
+				span.AddEvent("retry.succeeded", trace.WithAttributes(attribute.Int("retry.attempt", attempt)))
+			}
+			return result, nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return result, permanent.err
+		}
+
+		if attempt == maxAttempts || (policy.RetryOn != nil && !policy.RetryOn(err)) {
+			span.AddEvent("retry.exhausted", trace.WithAttributes(
+				attribute.Int("retry.attempt", attempt),
+				attribute.String("retry.error", err.Error()),
+			))
+			return result, err
+		}
+
+		var delay time.Duration
+		if policy.Backoff != nil {
+			delay = policy.Backoff.Next(attempt)
+		}
+
+		span.AddEvent("retry.attempt", trace.WithAttributes(
+			attribute.Int("retry.attempt", attempt),
+			attribute.Int("retry.max_attempts", maxAttempts),
+			attribute.String("retry.error", err.Error()),
+			attribute.Float64("retry.delay_ms", float64(delay.Microseconds())/1000),
+		))
+
+		if err := sleep(ctx, delay); err != nil {
+			return result, err
+		}
+	}
+
+	return result, err
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errFatal     = errors.New("fatal")
+)
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       Policy
+		errs         []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{"success first attempt", Policy{MaxAttempts: 3}, []error{nil}, nil, 1},
+		{"success after retries", Policy{MaxAttempts: 3}, []error{errTransient, errTransient, nil}, nil, 3},
+		{"exhausted", Policy{MaxAttempts: 2}, []error{errTransient, errTransient, nil}, errTransient, 2},
+		{"zero attempts runs once", Policy{}, []error{errTransient, nil}, errTransient, 1},
+		{"permanent stops", Policy{MaxAttempts: 3}, []error{Permanent(errFatal), nil}, errFatal, 1},
+		{"retry on filter stops", Policy{MaxAttempts: 3, RetryOn: RetryOnErrors(errTransient)}, []error{errFatal, nil}, errFatal, 1},
+		{"retry on filter retries", Policy{MaxAttempts: 3, RetryOn: RetryOnErrors(errTransient)}, []error{errTransient, nil}, nil, 2},
+		{"constant backoff", Policy{MaxAttempts: 2, Backoff: Constant(time.Millisecond)}, []error{errTransient, nil}, nil, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(context.Background(), tt.policy, func(context.Context) error {
+				err := tt.errs[attempts]
+				attempts++
+				return err
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Retry error = %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryPermanentUnwrapped(t *testing.T) {
+	err := Retry(context.Background(), Policy{MaxAttempts: 3}, func(context.Context) error {
+		return Permanent(errFatal)
+	})
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		t.Errorf("Retry returned wrapped permanent error %v", err)
+	}
+	if Permanent(nil) != nil {
+		t.Error("Permanent(nil) != nil")
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	err := Retry(ctx, Policy{MaxAttempts: 5, Backoff: Constant(time.Hour)}, func(context.Context) error {
+		attempts++
+		cancel()
+		return errTransient
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Retry error = %v, want %v", err, context.Canceled)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestDo(t *testing.T) {
+	attempts := 0
+	got, err := Do(context.Background(), Policy{MaxAttempts: 3}, func(context.Context) (int, error) {
+		attempts++
+		if attempts < 2 {
+			return 0, errTransient
+		}
+		return 42, nil
+	})
+
+	if err != nil || got != 42 {
+		t.Errorf("Do = (%d, %v), want (42, nil)", got, err)
+	}
+}
@@ -0,0 +1,66 @@
+package datadog
+
+import (
+	"context"
+	"log"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+type (
+	datadog struct {
+		config       otel.Config
+		collectorURL string
+		insecure     string
+		protocol     string
+		env          string
+		version      string
+	}
+
+	Config struct {
+		otel.Config
+		CollectorURL string
+		Insecure     string
+		Protocol     string
+		Env          string
+		Version      string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	d := &datadog{
+		config:       cfg.Config,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+		env:          cfg.Env,
+		version:      cfg.Version,
+	}
+
+	return otel.New(cfg.Config, d.InitTracer)
+}
+
+func (d *datadog) InitTracer() func(context.Context) error {
+	exporter, err := otlptrace.New(context.Background(), otel.NewOTLPClient(d.collectorURL, d.insecure, d.protocol, nil))
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	var attributes []attribute.KeyValue
+	if d.env != "" {
+		attributes = append(attributes, attribute.String("deployment.environment", d.env))
+	}
+	if d.version != "" {
+		attributes = append(attributes, attribute.String("service.version", d.version))
+	}
+
+	return otel.InitConfigProvider(
+		d.config,
+		&spanExporter{SpanExporter: exporter},
+		otel.WithResourceAttributes(attributes...),
+	)
+}
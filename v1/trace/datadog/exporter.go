@@ -0,0 +1,134 @@
+package datadog
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	spanExporter struct {
+		sdktrace.SpanExporter
+	}
+
+	mappedSpan struct {
+		sdktrace.ReadOnlySpan
+		attributes []attribute.KeyValue
+	}
+)
+
+func (e *spanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	mapped := make([]sdktrace.ReadOnlySpan, len(spans))
+	for i, span := range spans {
+		mapped[i] = mapSpan(span)
+	}
+
+	return e.SpanExporter.ExportSpans(ctx, mapped)
+}
+
+func (s *mappedSpan) Attributes() []attribute.KeyValue {
+	return s.attributes
+}
+
+func mapSpan(span sdktrace.ReadOnlySpan) sdktrace.ReadOnlySpan {
+	original := span.Attributes()
+
+	values := make(map[attribute.Key]attribute.Value, len(original))
+	for _, kv := range original {
+		values[kv.Key] = kv.Value
+	}
+
+	attributes := make([]attribute.KeyValue, len(original), len(original)+6)
+	copy(attributes, original)
+	attributes = append(attributes,
+		attribute.String("span.type", spanType(span.SpanKind(), values)),
+		attribute.String("resource.name", span.Name()),
+	)
+	if name := operationName(span.SpanKind(), values); name != "" {
+		attributes = append(attributes, attribute.String("operation.name", name))
+	}
+
+	if span.Status().Code == codes.Error {
+		attributes = append(attributes, errorAttributes(span)...)
+	}
+
+	return &mappedSpan{ReadOnlySpan: span, attributes: attributes}
+}
+
+func spanType(kind trace.SpanKind, values map[attribute.Key]attribute.Value) string {
+	if system, ok := values[semconv.DBSystemKey]; ok {
+		switch system.AsString() {
+		case "redis", "memcached":
+			return "cache"
+		default:
+			return "sql"
+		}
+	}
+
+	switch kind {
+	case trace.SpanKindServer:
+		return "web"
+	case trace.SpanKindClient:
+		if _, ok := values[semconv.HTTPMethodKey]; ok {
+			return "http"
+		}
+		return "custom"
+	case trace.SpanKindProducer, trace.SpanKindConsumer:
+		return "queue"
+	default:
+		return "custom"
+	}
+}
+
+func operationName(kind trace.SpanKind, values map[attribute.Key]attribute.Value) string {
+	if system, ok := values[semconv.DBSystemKey]; ok {
+		return system.AsString() + ".query"
+	}
+
+	if _, ok := values[semconv.HTTPMethodKey]; ok {
+		switch kind {
+		case trace.SpanKindServer:
+			return "http.server.request"
+		case trace.SpanKindClient:
+			return "http.client.request"
+		}
+	}
+
+	if system, ok := values[semconv.MessagingSystemKey]; ok {
+		return system.AsString() + "." + kind.String()
+	}
+
+	return ""
+}
+
+func errorAttributes(span sdktrace.ReadOnlySpan) []attribute.KeyValue {
+	errors := map[string]string{"error.message": span.Status().Description}
+
+	for _, event := range span.Events() {
+		if event.Name != semconv.ExceptionEventName {
+			continue
+		}
+
+		for _, kv := range event.Attributes {
+			switch kv.Key {
+			case semconv.ExceptionMessageKey:
+				errors["error.message"] = kv.Value.AsString()
+			case semconv.ExceptionTypeKey:
+				errors["error.type"] = kv.Value.AsString()
+			case semconv.ExceptionStacktraceKey:
+				errors["error.stack"] = kv.Value.AsString()
+			}
+		}
+	}
+
+	attributes := make([]attribute.KeyValue, 0, len(errors))
+	for key, value := range errors {
+		attributes = append(attributes, attribute.String(key, value))
+	}
+
+	return attributes
+}
@@ -0,0 +1,47 @@
+package jaeger
+
+import (
+	"context"
+	"log"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+type (
+	jaeger struct {
+		config       otel.Config
+		collectorURL string
+		insecure     string
+		protocol     string
+	}
+
+	Config struct {
+		otel.Config
+		CollectorURL string
+		Insecure     string
+		Protocol     string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	j := &jaeger{
+		config:       cfg.Config,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+	}
+
+	return otel.New(cfg.Config, j.InitTracer)
+}
+
+func (j *jaeger) InitTracer() func(context.Context) error {
+	exporter, err := otlptrace.New(context.Background(), otel.NewOTLPClient(j.collectorURL, j.insecure, j.protocol, nil))
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	return otel.InitConfigProvider(j.config, exporter)
+}
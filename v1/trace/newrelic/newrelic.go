@@ -0,0 +1,76 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+const (
+	defaultHost   = "otlp.nr-data.net"
+	defaultEUHost = "otlp.eu01.nr-data.net"
+	grpcPort      = ":4317"
+	httpPort      = ":4318"
+)
+
+type (
+	newrelic struct {
+		config       otel.Config
+		collectorURL string
+		protocol     string
+		licenseKey   string
+	}
+
+	Config struct {
+		otel.Config
+		LicenseKey   string
+		Region       string
+		CollectorURL string
+		Protocol     string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	collectorURL := cfg.CollectorURL
+	if collectorURL == "" {
+		collectorURL = defaultEndpoint(cfg.Region, cfg.Protocol)
+	}
+
+	n := &newrelic{
+		config:       cfg.Config,
+		collectorURL: collectorURL,
+		protocol:     cfg.Protocol,
+		licenseKey:   cfg.LicenseKey,
+	}
+
+	return otel.New(cfg.Config, n.InitTracer)
+}
+
+func defaultEndpoint(region, protocol string) string {
+	host := defaultHost
+	if strings.ToLower(region) == "eu" {
+		host = defaultEUHost
+	}
+
+	if strings.ToLower(protocol) == "http" {
+		return host + httpPort
+	}
+
+	return host + grpcPort
+}
+
+func (n *newrelic) InitTracer() func(context.Context) error {
+	headers := map[string]string{"api-key": n.licenseKey}
+
+	exporter, err := otlptrace.New(context.Background(), otel.NewOTLPClient(n.collectorURL, "false", n.protocol, headers))
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	return otel.InitConfigProvider(n.config, exporter)
+}
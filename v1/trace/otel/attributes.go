@@ -0,0 +1,77 @@
+package otel
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const attributePoolCapacity = 16
+
+var attributePool = sync.Pool{
+	New: func() interface{} {
+		attributes := make([]attribute.KeyValue, 0, attributePoolCapacity)
+		return &attributes
+	},
+}
+
+func getAttributes(keyValue []KeyValue) *[]attribute.KeyValue {
+	attributes := attributePool.Get().(*[]attribute.KeyValue)
+	for _, item := range keyValue {
+		*attributes = append(*attributes, attribute.String(attributeKey(item.Key), item.Value))
+	}
+
+	return attributes
+}
+
+func putAttributes(attributes *[]attribute.KeyValue) {
+	if cap(*attributes) > 4*attributePoolCapacity {
+		return
+	}
+
+	for i := range *attributes {
+		(*attributes)[i] = attribute.KeyValue{}
+	}
+	*attributes = (*attributes)[:0]
+	attributePool.Put(attributes)
+}
+
+func attributeKey(key string) string {
+	if loadSettings().namespaceKeys {
+		key = snakeCase(key)
+	}
+
+	return loadSettings().attributePrefix + key
+}
+
+func snakeCase(key string) string {
+	runes := []rune(key)
+
+	var builder strings.Builder
+	builder.Grow(len(key) + 4)
+
+	previous := '_'
+	for i, r := range runes {
+		switch {
+		case r == ' ' || r == '-' || r == '_':
+			if previous != '_' && previous != '.' {
+				builder.WriteByte('_')
+				previous = '_'
+			}
+			continue
+		case unicode.IsUpper(r):
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if previous != '_' && previous != '.' && (!unicode.IsUpper(previous) || nextLower) {
+				builder.WriteByte('_')
+			}
+			builder.WriteRune(unicode.ToLower(r))
+		default:
+			builder.WriteRune(r)
+		}
+		previous = r
+	}
+
+	return strings.TrimRight(builder.String(), "_")
+}
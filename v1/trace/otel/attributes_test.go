@@ -0,0 +1,119 @@
+package otel
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTracer(tb testing.TB) trace.Tracer {
+	tb.Helper()
+
+	provider := sdktrace.NewTracerProvider()
+	tb.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return provider.Tracer("attributes_test")
+}
+
+func newTestSpan(tb testing.TB) trace.Span {
+	tb.Helper()
+
+	_, span := newTestTracer(tb).Start(context.Background(), "span")
+
+	return span
+}
+
+func testKeyValues(n int) []KeyValue {
+	keyValue := make([]KeyValue, n)
+	for i := range keyValue {
+		keyValue[i] = KeyValue{Key: "key" + strconv.Itoa(i), Value: "value" + strconv.Itoa(i)}
+	}
+
+	return keyValue
+}
+
+func attributeMap(attributes []attribute.KeyValue) map[string]string {
+	m := make(map[string]string, len(attributes))
+	for _, kv := range attributes {
+		m[string(kv.Key)] = kv.Value.Emit()
+	}
+
+	return m
+}
+
+func dirtyPool() {
+	for i := 0; i < 8; i++ {
+		attributes := attributePool.Get().(*[]attribute.KeyValue)
+		*attributes = append(*attributes, attribute.String("key0", "overwritten"))
+		putAttributes(attributes)
+	}
+}
+
+func TestSetSpanAttributesDoesNotRetainPooledBuffer(t *testing.T) {
+	span := newTestSpan(t)
+
+	SetSpanAttributes(span, testKeyValues(4))
+	dirtyPool()
+	span.End()
+
+	got := attributeMap(span.(sdktrace.ReadOnlySpan).Attributes())
+	for i := 0; i < 4; i++ {
+		key := "key" + strconv.Itoa(i)
+		if want := "value" + strconv.Itoa(i); got[key] != want {
+			t.Errorf("attribute %q = %q, want %q", key, got[key], want)
+		}
+	}
+}
+
+func TestAddSpanEventDoesNotRetainPooledBuffer(t *testing.T) {
+	span := newTestSpan(t)
+
+	AddSpanEvent(span, "event", testKeyValues(4))
+	dirtyPool()
+	span.End()
+
+	events := span.(sdktrace.ReadOnlySpan).Events()
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	got := attributeMap(events[0].Attributes)
+	for i := 0; i < 4; i++ {
+		key := "key" + strconv.Itoa(i)
+		if want := "value" + strconv.Itoa(i); got[key] != want {
+			t.Errorf("event attribute %q = %q, want %q", key, got[key], want)
+		}
+	}
+}
+
+func BenchmarkSetSpanAttributes(b *testing.B) {
+	span := newTestSpan(b)
+	keyValue := testKeyValues(8)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SetSpanAttributes(span, keyValue)
+	}
+}
+
+func BenchmarkAddSpanEvent(b *testing.B) {
+	tracer := newTestTracer(b)
+	keyValue := testKeyValues(8)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		_, span := tracer.Start(context.Background(), "span")
+		b.StartTimer()
+
+		AddSpanEvent(span, "event", keyValue)
+	}
+}
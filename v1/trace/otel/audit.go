@@ -0,0 +1,76 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	AuditEvent struct {
+		Actor    string
+		Action   string
+		Resource string
+		Before   string
+		After    string
+		Time     time.Time
+	}
+
+	AuditHook func(ctx context.Context, event AuditEvent)
+)
+
+const auditEventName = "Audit"
+
+func (t *tracing) AddAuditEvent(ctx context.Context, actor, action, resource string, before, after interface{}) {
+	AddAuditEvent(ctx, actor, action, resource, before, after)
+}
+
+func AddAuditEvent(ctx context.Context, actor, action, resource string, before, after interface{}) {
+	event := AuditEvent{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+		Before:   renderAuditState(before),
+		After:    renderAuditState(after),
+		Time:     time.Now(),
+	}
+
+	if hook := loadSettings().auditHook; hook != nil {
+		hook(ctx, event)
+	}
+
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(auditEventName, trace.WithTimestamp(event.Time), trace.WithAttributes(
+		attribute.String("audit.actor", event.Actor),
+		attribute.String("audit.action", event.Action),
+		attribute.String("audit.resource", event.Resource),
+		attribute.String("audit.before", event.Before),
+		attribute.String("audit.after", event.After),
+	))
+}
+
+func renderAuditState(state interface{}) string {
+	if state == nil {
+		return ""
+	}
+
+	body, err := json.Marshal(state)
+	if err != nil {
+		log.Printf("Failed to marshal audit state: %v", err)
+		return ""
+	}
+
+	return string(Mask(jsonContentType, body))
+}
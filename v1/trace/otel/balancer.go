@@ -0,0 +1,76 @@
+package otel
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const roundRobinServiceConfig = `{"loadBalancingConfig":[{"round_robin":{}}]}`
+
+type balancedExporter struct {
+	exporters []sdktrace.SpanExporter
+	next      uint64
+}
+
+func NewBalancedExporter(exporters ...sdktrace.SpanExporter) sdktrace.SpanExporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+
+	return &balancedExporter{exporters: exporters}
+}
+
+func (e *balancedExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	start := atomic.AddUint64(&e.next, 1)
+
+	var err error
+	for i := range e.exporters {
+		exporter := e.exporters[(start+uint64(i))%uint64(len(e.exporters))]
+		if i == 0 {
+			err = exporter.ExportSpans(ctx, spans)
+		} else {
+			err = e.exportNext(ctx, exporter, spans)
+		}
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func (e *balancedExporter) exportNext(ctx context.Context, exporter sdktrace.SpanExporter, spans []sdktrace.ReadOnlySpan) error {
+	ctx, cancel := fallbackContext(ctx)
+	defer cancel()
+
+	return exporter.ExportSpans(ctx, spans)
+}
+
+func (e *balancedExporter) Shutdown(ctx context.Context) error {
+	var err error
+	for _, exporter := range e.exporters {
+		if shutdownErr := exporter.Shutdown(ctx); err == nil {
+			err = shutdownErr
+		}
+	}
+
+	return err
+}
+
+func SplitCollectorURLs(collectorURL string) []string {
+	var urls []string
+	for _, url := range strings.Split(collectorURL, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	if len(urls) == 0 {
+		return []string{collectorURL}
+	}
+
+	return urls
+}
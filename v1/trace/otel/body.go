@@ -0,0 +1,92 @@
+package otel
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BodyBuffer struct {
+	buf      bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func NewBodyBuffer() *BodyBuffer {
+	return &BodyBuffer{limit: bodyLimit()}
+}
+
+func (b *BodyBuffer) Write(p []byte) (int, error) {
+	if b.overflow || b.limit <= 0 {
+		return len(p), nil
+	}
+
+	if b.buf.Len()+len(p) > b.limit {
+		b.overflow = true
+		b.buf.Reset()
+		return len(p), nil
+	}
+
+	return b.buf.Write(p)
+}
+
+func (b *BodyBuffer) Bytes() []byte {
+	if b.overflow {
+		return nil
+	}
+
+	return b.buf.Bytes()
+}
+
+func BodyCaptureEnabled() bool {
+	return loadSettings().captureBodySize > 0
+}
+
+func CaptureRequestBody(span trace.Span, r *http.Request) {
+	if !BodyCaptureEnabled() || r.Body == nil || r.Body == http.NoBody {
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if !isCapturableContentType(contentType) {
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, int64(bodyLimit())+1))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	if err != nil || len(body) > bodyLimit() {
+		return
+	}
+
+	CaptureBody(span, "Request", contentType, body)
+}
+
+func CaptureBody(span trace.Span, name, contentType string, body []byte) {
+	if !BodyCaptureEnabled() || len(body) == 0 || len(body) > bodyLimit() || !isCapturableContentType(contentType) {
+		return
+	}
+
+	if len(body) > loadSettings().captureBodySize {
+		offloadBody(span, name, contentType, body)
+		return
+	}
+
+	AddSpanEvent(span, name, []KeyValue{
+		{
+			Key:   "Body",
+			Value: string(Mask(contentType, body)),
+		},
+	})
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func isCapturableContentType(contentType string) bool {
+	return mask.IsJSONContentType(contentType) || mask.IsFormContentType(contentType)
+}
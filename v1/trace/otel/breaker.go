@@ -0,0 +1,64 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+const (
+	breakerNameKey  = attribute.Key("circuit_breaker.name")
+	breakerStateKey = attribute.Key("circuit_breaker.state")
+)
+
+func RecordBreakerState(ctx context.Context, name string, from, to BreakerState) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("circuit_breaker.state_change", trace.WithAttributes(
+		breakerNameKey.String(name),
+		attribute.String("circuit_breaker.from", string(from)),
+		attribute.String("circuit_breaker.to", string(to)),
+	))
+	span.SetAttributes(breakerNameKey.String(name), breakerStateKey.String(string(to)))
+}
+
+func SetBreakerState(span trace.Span, name string, state BreakerState) {
+	if !Enabled() {
+		return
+	}
+
+	span.SetAttributes(breakerNameKey.String(name), breakerStateKey.String(string(state)))
+}
+
+func RecordBreakerRejection(ctx context.Context, name string, state BreakerState, err error) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("circuit_breaker.rejected", trace.WithAttributes(
+		breakerNameKey.String(name),
+		breakerStateKey.String(string(state)),
+	))
+	span.SetAttributes(
+		breakerNameKey.String(name),
+		breakerStateKey.String(string(state)),
+		attribute.Bool("circuit_breaker.fast_fail", true),
+	)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,37 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func RecordCancellation(ctx context.Context, start time.Time) bool {
+	err := ctx.Err()
+	if err == nil || !Enabled() {
+		return false
+	}
+
+	name := "context.cancelled"
+	if errors.Is(err, context.DeadlineExceeded) {
+		name = "context.deadline_exceeded"
+	}
+
+	trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(
+		attribute.Float64("elapsed_ms", float64(time.Since(start).Microseconds())/1000),
+	))
+
+	return true
+}
+
+func CancellationMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		h.ServeHTTP(w, r)
+		RecordCancellation(r.Context(), start)
+	})
+}
@@ -0,0 +1,26 @@
+package otel
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	enabled  int32 = 1
+	noopSpan       = trace.SpanFromContext(context.Background())
+)
+
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+func SetEnabled(value bool) {
+	if value {
+		atomic.StoreInt32(&enabled, 1)
+		return
+	}
+
+	atomic.StoreInt32(&enabled, 0)
+}
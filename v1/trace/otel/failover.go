@@ -0,0 +1,135 @@
+package otel
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	failoverThreshold = 3
+	failbackInterval  = 30 * time.Second
+	fallbackTimeout   = 10 * time.Second
+)
+
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func fallbackContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(detachedContext{Context: ctx}, fallbackTimeout)
+}
+
+type failoverExporter struct {
+	mu           sync.Mutex
+	primary      sdktrace.SpanExporter
+	secondary    sdktrace.SpanExporter
+	failures     int
+	failedOver   bool
+	failedOverAt time.Time
+	counter      metric.Int64Counter
+}
+
+func NewFailoverExporter(primary, secondary sdktrace.SpanExporter) sdktrace.SpanExporter {
+	counter, err := otelapi.Meter("github.com/elraghifary/go-modules/v1/trace/otel").Int64Counter(
+		"trace.exporter.failover",
+		metric.WithDescription("Number of times span export switched between primary and secondary collectors"),
+	)
+	if err != nil {
+		log.Printf("Failed to create failover counter: %v", err)
+	}
+
+	return &failoverExporter{
+		primary:   primary,
+		secondary: secondary,
+		counter:   counter,
+	}
+}
+
+func (e *failoverExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	usePrimary := !e.failedOver || time.Since(e.failedOverAt) >= failbackInterval
+	e.mu.Unlock()
+
+	if usePrimary {
+		err := e.primary.ExportSpans(ctx, spans)
+		if err == nil {
+			e.recordSuccess(ctx)
+			return nil
+		}
+
+		e.recordFailure(ctx, err)
+
+		secondaryCtx, cancel := fallbackContext(ctx)
+		defer cancel()
+
+		return e.secondary.ExportSpans(secondaryCtx, spans)
+	}
+
+	return e.secondary.ExportSpans(ctx, spans)
+}
+
+func (e *failoverExporter) recordSuccess(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures = 0
+	if e.failedOver {
+		e.failedOver = false
+		e.record(ctx, "primary")
+		log.Printf("Primary collector recovered, failing back")
+	}
+}
+
+func (e *failoverExporter) recordFailure(ctx context.Context, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.failedOver {
+		e.failedOverAt = time.Now()
+		return
+	}
+
+	e.failures++
+	if e.failures < failoverThreshold {
+		return
+	}
+
+	e.failedOver = true
+	e.failedOverAt = time.Now()
+	e.record(ctx, "secondary")
+	log.Printf("Primary collector failed %d consecutive exports, failing over to secondary: %v", e.failures, err)
+}
+
+func (e *failoverExporter) record(ctx context.Context, target string) {
+	if e.counter != nil {
+		e.counter.Add(ctx, 1, metric.WithAttributes(attribute.String("target", target)))
+	}
+}
+
+func (e *failoverExporter) Shutdown(ctx context.Context) error {
+	err := e.primary.Shutdown(ctx)
+	if secondaryErr := e.secondary.Shutdown(ctx); err == nil {
+		err = secondaryErr
+	}
+
+	return err
+}
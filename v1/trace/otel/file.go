@@ -0,0 +1,149 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type (
+	FileOutput struct {
+		Path       string
+		MaxSizeMB  int
+		MaxAgeDays int
+		MaxBackups int
+		Compress   bool
+	}
+
+	fileExporter struct {
+		mu      sync.Mutex
+		writer  *lumberjack.Logger
+		encoder *json.Encoder
+	}
+
+	spanRecord struct {
+		TraceID      string            `json:"trace_id"`
+		SpanID       string            `json:"span_id"`
+		ParentSpanID string            `json:"parent_span_id,omitempty"`
+		Name         string            `json:"name"`
+		Kind         string            `json:"kind"`
+		StartTime    time.Time         `json:"start_time"`
+		EndTime      time.Time         `json:"end_time"`
+		Attributes   []attributeRecord `json:"attributes,omitempty"`
+		Events       []eventRecord     `json:"events,omitempty"`
+		Links        []linkRecord      `json:"links,omitempty"`
+		StatusCode   string            `json:"status_code"`
+		StatusDesc   string            `json:"status_description,omitempty"`
+		Resource     []attributeRecord `json:"resource,omitempty"`
+		Scope        string            `json:"scope,omitempty"`
+		ScopeVersion string            `json:"scope_version,omitempty"`
+	}
+
+	attributeRecord struct {
+		Key   string      `json:"key"`
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}
+
+	eventRecord struct {
+		Name       string            `json:"name"`
+		Time       time.Time         `json:"time"`
+		Attributes []attributeRecord `json:"attributes,omitempty"`
+	}
+
+	linkRecord struct {
+		TraceID    string            `json:"trace_id"`
+		SpanID     string            `json:"span_id"`
+		Attributes []attributeRecord `json:"attributes,omitempty"`
+	}
+)
+
+func NewFileExporter(file FileOutput) sdktrace.SpanExporter {
+	writer := &lumberjack.Logger{
+		Filename:   file.Path,
+		MaxSize:    file.MaxSizeMB,
+		MaxAge:     file.MaxAgeDays,
+		MaxBackups: file.MaxBackups,
+		Compress:   file.Compress,
+	}
+
+	return &fileExporter{writer: writer, encoder: json.NewEncoder(writer)}
+}
+
+func (e *fileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, span := range spans {
+		if err := e.encoder.Encode(newSpanRecord(span)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.writer.Close()
+}
+
+func newSpanRecord(span sdktrace.ReadOnlySpan) spanRecord {
+	record := spanRecord{
+		TraceID:      span.SpanContext().TraceID().String(),
+		SpanID:       span.SpanContext().SpanID().String(),
+		Name:         span.Name(),
+		Kind:         span.SpanKind().String(),
+		StartTime:    span.StartTime(),
+		EndTime:      span.EndTime(),
+		Attributes:   newAttributeRecords(span.Attributes()),
+		StatusCode:   span.Status().Code.String(),
+		StatusDesc:   span.Status().Description,
+		Scope:        span.InstrumentationScope().Name,
+		ScopeVersion: span.InstrumentationScope().Version,
+	}
+	if span.Parent().HasSpanID() {
+		record.ParentSpanID = span.Parent().SpanID().String()
+	}
+	if span.Resource() != nil {
+		record.Resource = newAttributeRecords(span.Resource().Attributes())
+	}
+
+	for _, event := range span.Events() {
+		record.Events = append(record.Events, eventRecord{
+			Name:       event.Name,
+			Time:       event.Time,
+			Attributes: newAttributeRecords(event.Attributes),
+		})
+	}
+
+	for _, link := range span.Links() {
+		record.Links = append(record.Links, linkRecord{
+			TraceID:    link.SpanContext.TraceID().String(),
+			SpanID:     link.SpanContext.SpanID().String(),
+			Attributes: newAttributeRecords(link.Attributes),
+		})
+	}
+
+	return record
+}
+
+func newAttributeRecords(attributes []attribute.KeyValue) []attributeRecord {
+	records := make([]attributeRecord, 0, len(attributes))
+	for _, attr := range attributes {
+		records = append(records, attributeRecord{
+			Key:   string(attr.Key),
+			Type:  attr.Value.Type().String(),
+			Value: attr.Value.AsInterface(),
+		})
+	}
+
+	return records
+}
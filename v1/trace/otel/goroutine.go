@@ -0,0 +1,104 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GoOption interface {
+	apply(goConfig) goConfig
+}
+
+type goConfig struct {
+	ChildSpan bool
+	SpanName  string
+}
+
+type goOption func(goConfig) goConfig
+
+func (fn goOption) apply(config goConfig) goConfig {
+	return fn(config)
+}
+
+func WithChildSpan() GoOption {
+	return goOption(func(config goConfig) goConfig {
+		config.ChildSpan = true
+		return config
+	})
+}
+
+func WithGoSpanName(spanName string) GoOption {
+	return goOption(func(config goConfig) goConfig {
+		config.ChildSpan = true
+		config.SpanName = spanName
+		return config
+	})
+}
+
+func Go(ctx context.Context, fn func(ctx context.Context) error, opts ...GoOption) {
+	goConfig := goConfig{}
+	for _, opt := range opts {
+		goConfig = opt.apply(goConfig)
+	}
+
+	ctx = trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+
+	go func() {
+		span := trace.SpanFromContext(ctx)
+		if goConfig.ChildSpan {
+			spanName := goConfig.SpanName
+			if spanName == "" {
+				spanName = funcName(fn)
+			}
+
+			ctx, span = Tracer().Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindInternal))
+			defer span.End()
+		}
+
+		defer RecoverSpan(span)
+
+		if err := fn(ctx); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+}
+
+func RecoverSpan(span trace.Span) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("panic: %v", r)
+	}
+
+	stack := string(debug.Stack())
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err, trace.WithAttributes(
+		attribute.Bool("exception.escaped", true),
+		attribute.String("exception.stacktrace", stack),
+	))
+
+	log.Printf("Recovered from panic in goroutine: %v\n%s", err, stack)
+}
+
+func funcName(fn interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
@@ -0,0 +1,62 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+type TracedGroup struct {
+	group *errgroup.Group
+	ctx   context.Context
+	span  trace.Span
+}
+
+func Group(ctx context.Context) (*TracedGroup, context.Context) {
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	return &TracedGroup{
+		group: group,
+		ctx:   groupCtx,
+		span:  trace.SpanFromContext(ctx),
+	}, groupCtx
+}
+
+func (g *TracedGroup) SetLimit(n int) {
+	g.group.SetLimit(n)
+}
+
+func (g *TracedGroup) Go(spanName string, fn func(ctx context.Context) error) {
+	g.group.Go(func() error {
+		return g.run(spanName, fn)
+	})
+}
+
+func (g *TracedGroup) TryGo(spanName string, fn func(ctx context.Context) error) bool {
+	return g.group.TryGo(func() error {
+		return g.run(spanName, fn)
+	})
+}
+
+func (g *TracedGroup) Wait() error {
+	return g.group.Wait()
+}
+
+func (g *TracedGroup) run(spanName string, fn func(ctx context.Context) error) error {
+	ctx, span := Tracer().Start(g.ctx, spanName, trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	err := fn(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		g.span.RecordError(err, trace.WithAttributes(
+			attribute.String("task.name", spanName),
+		))
+	}
+
+	return err
+}
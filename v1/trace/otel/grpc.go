@@ -0,0 +1,145 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const jsonContentType = "application/json"
+
+func (t *tracing) TraceGrpcRequest(ctx context.Context, fullMethod string, req interface{}) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	service, method := splitFullMethod(fullMethod)
+	span.SetAttributes(
+		semconv.RPCSystemKey.String("grpc"),
+		semconv.RPCServiceKey.String(service),
+		semconv.RPCMethodKey.String(method),
+	)
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		captureMetadata(span, "rpc.request.metadata.", md)
+	}
+
+	payload, size := renderMessage(req)
+	AddSpanEvent(span, "Request", []KeyValue{
+		{
+			Key:   "Method",
+			Value: fullMethod,
+		},
+		{
+			Key:   "Size",
+			Value: strconv.Itoa(size),
+		},
+		{
+			Key:   "Payload",
+			Value: payload,
+		},
+	})
+}
+
+func (t *tracing) TraceGrpcResponse(ctx context.Context, resp interface{}, err error) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	s := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(s.Code())))
+	if err != nil {
+		span.SetStatus(codes.Error, s.Message())
+	}
+
+	payload, size := renderMessage(resp)
+	AddSpanEvent(span, "Response", []KeyValue{
+		{
+			Key:   "Code",
+			Value: s.Code().String(),
+		},
+		{
+			Key:   "Message",
+			Value: s.Message(),
+		},
+		{
+			Key:   "Size",
+			Value: strconv.Itoa(size),
+		},
+		{
+			Key:   "Data",
+			Value: payload,
+		},
+	})
+}
+
+func renderMessage(msg interface{}) (string, int) {
+	if msg == nil {
+		return "", 0
+	}
+
+	var (
+		body []byte
+		size int
+		err  error
+	)
+	if message, ok := msg.(proto.Message); ok {
+		size = proto.Size(message)
+		body, err = protojson.Marshal(message)
+	} else {
+		body, err = json.Marshal(msg)
+		size = len(body)
+	}
+	if err != nil {
+		return "", size
+	}
+
+	return string(Mask(jsonContentType, body)), size
+}
+
+func captureMetadata(span trace.Span, prefix string, md metadata.MD) {
+	var attributes []attribute.KeyValue
+
+	for _, name := range loadSettings().captureHeaders {
+		values := md.Get(name)
+		if len(values) == 0 {
+			continue
+		}
+
+		key := prefix + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+		attributes = append(attributes, attribute.StringSlice(key, values))
+	}
+
+	if len(attributes) > 0 {
+		span.SetAttributes(attributes...)
+	}
+}
+
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "", fullMethod
+}
@@ -0,0 +1,176 @@
+package otel
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	size        int
+	wroteHeader bool
+	body        *BodyBuffer
+}
+
+func NewResponseWriter(w http.ResponseWriter) *ResponseWriter {
+	rw := &ResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+	if BodyCaptureEnabled() {
+		rw.body = NewBodyBuffer()
+	}
+
+	return rw
+}
+
+func (w *ResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *ResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	if w.body != nil {
+		w.body.Write(b[:n])
+	}
+
+	return n, err
+}
+
+func (w *ResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not implement http.Hijacker")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && !w.wroteHeader {
+		w.statusCode = http.StatusSwitchingProtocols
+		w.wroteHeader = true
+	}
+
+	return conn, rw, err
+}
+
+func (w *ResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *ResponseWriter) StatusCode() int {
+	return w.statusCode
+}
+
+func (w *ResponseWriter) Size() int {
+	return w.size
+}
+
+func (w *ResponseWriter) End(span trace.Span, start time.Time) {
+	CaptureResponseHeaders(span, w.Header())
+	if w.body != nil {
+		CaptureBody(span, "Response", w.Header().Get("Content-Type"), w.body.Bytes())
+	}
+	EndHTTPServerSpan(span, w.statusCode, w.size, start)
+}
+
+func HTTPMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !Enabled() {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+
+		ctx, span := StartHTTPServerSpan(r, Route(r.URL.Path))
+		defer span.End()
+
+		rw := NewResponseWriter(w)
+		h.ServeHTTP(rw, r.WithContext(ctx))
+		RecordCancellation(ctx, start)
+
+		rw.End(span, start)
+	})
+}
+
+func StartHTTPServerSpan(r *http.Request, route string) (context.Context, trace.Span) {
+	ctx := otelapi.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+	ctx, span := Tracer().Start(
+		ctx,
+		HTTPServerSpanName(r.Method, route),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("", route, r)...),
+	)
+	CaptureRequestHeaders(span, r.Header)
+	CaptureRequestBody(span, r)
+
+	return ctx, span
+}
+
+func HTTPServerSpanName(method, route string) string {
+	if route == "" {
+		return "HTTP " + method
+	}
+
+	return method + " " + route
+}
+
+func CaptureRequestHeaders(span trace.Span, header http.Header) {
+	captureHeaderAttributes(span, "http.request.header.", header)
+}
+
+func CaptureResponseHeaders(span trace.Span, header http.Header) {
+	captureHeaderAttributes(span, "http.response.header.", header)
+}
+
+func captureHeaderAttributes(span trace.Span, prefix string, header http.Header) {
+	var attributes []attribute.KeyValue
+
+	for _, name := range loadSettings().captureHeaders {
+		values := header.Values(name)
+		if len(values) == 0 {
+			continue
+		}
+
+		key := prefix + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+		attributes = append(attributes, attribute.StringSlice(key, values))
+	}
+
+	if len(attributes) > 0 {
+		span.SetAttributes(attributes...)
+	}
+}
+
+func EndHTTPServerSpan(span trace.Span, statusCode, size int, start time.Time) {
+	waitResponse(span)
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(statusCode)...)
+	span.SetAttributes(
+		semconv.HTTPResponseContentLengthKey.Int(size),
+		attribute.Float64("http.duration_ms", float64(time.Since(start).Microseconds())/1000),
+	)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(statusCode, trace.SpanKindServer))
+}
@@ -0,0 +1,47 @@
+package otel
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type deterministicIDGenerator struct {
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+func NewDeterministicIDGenerator(seed int64) sdktrace.IDGenerator {
+	return &deterministicIDGenerator{random: rand.New(rand.NewSource(seed))}
+}
+
+func (g *deterministicIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var traceID trace.TraceID
+	for !traceID.IsValid() {
+		_, _ = g.random.Read(traceID[:])
+	}
+
+	return traceID, g.newSpanID()
+}
+
+func (g *deterministicIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.newSpanID()
+}
+
+func (g *deterministicIDGenerator) newSpanID() trace.SpanID {
+	var spanID trace.SpanID
+	for !spanID.IsValid() {
+		_, _ = g.random.Read(spanID[:])
+	}
+
+	return spanID
+}
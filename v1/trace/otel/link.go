@@ -0,0 +1,38 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type linkContextKey struct{}
+
+func LinkFromContext(ctx context.Context) trace.Link {
+	return trace.LinkFromContext(ctx)
+}
+
+func ContextWithLink(producerCtx context.Context) context.Context {
+	link := LinkFromContext(producerCtx)
+	if !link.SpanContext.IsValid() {
+		return context.Background()
+	}
+
+	return context.WithValue(context.Background(), linkContextKey{}, []trace.Link{link})
+}
+
+func StartLinkedSpan(ctx context.Context, spanName string, linkedCtx context.Context) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{trace.WithNewRoot()}
+
+	link := LinkFromContext(linkedCtx)
+	if link.SpanContext.IsValid() {
+		opts = append(opts, trace.WithLinks(link))
+	}
+
+	return Tracer().Start(ctx, spanName, opts...)
+}
+
+func linksFromContext(ctx context.Context) []trace.Link {
+	links, _ := ctx.Value(linkContextKey{}).([]trace.Link)
+	return links
+}
@@ -0,0 +1,15 @@
+package otel
+
+import (
+	"github.com/elraghifary/go-modules/v1/mask"
+)
+
+type Masker = mask.Masker
+
+func AddMasker(masker Masker) {
+	mask.AddMasker(masker)
+}
+
+func Mask(contentType string, body []byte) []byte {
+	return mask.Body(contentType, body)
+}
@@ -0,0 +1,41 @@
+package otel
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type multiExporter struct {
+	exporters []sdktrace.SpanExporter
+}
+
+func NewMultiExporter(exporters ...sdktrace.SpanExporter) sdktrace.SpanExporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+
+	return &multiExporter{exporters: exporters}
+}
+
+func (e *multiExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	var err error
+	for _, exporter := range e.exporters {
+		if exportErr := exporter.ExportSpans(ctx, spans); err == nil {
+			err = exportErr
+		}
+	}
+
+	return err
+}
+
+func (e *multiExporter) Shutdown(ctx context.Context) error {
+	var err error
+	for _, exporter := range e.exporters {
+		if shutdownErr := exporter.Shutdown(ctx); err == nil {
+			err = shutdownErr
+		}
+	}
+
+	return err
+}
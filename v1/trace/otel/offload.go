@@ -0,0 +1,52 @@
+package otel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type PayloadStore func(ctx context.Context, key, contentType string, body []byte) (string, error)
+
+func bodyLimit() int {
+	settings := loadSettings()
+	if settings.payloadStore != nil && settings.offloadBodySize > settings.captureBodySize {
+		return settings.offloadBodySize
+	}
+
+	return settings.captureBodySize
+}
+
+func offloadBody(span trace.Span, name, contentType string, body []byte) {
+	masked := Mask(contentType, body)
+	sum := sha256.Sum256(masked)
+	hash := hex.EncodeToString(sum[:])
+
+	spanContext := span.SpanContext()
+	key := spanContext.TraceID().String() + "/" + spanContext.SpanID().String() + "/" + name + "-" + hash
+
+	url, err := loadSettings().payloadStore(trace.ContextWithSpan(context.Background(), span), key, contentType, masked)
+	if err != nil {
+		log.Printf("Failed to offload %s body: %v", name, err)
+		return
+	}
+
+	AddSpanEvent(span, name, []KeyValue{
+		{
+			Key:   "Body.Reference",
+			Value: url,
+		},
+		{
+			Key:   "Body.SHA256",
+			Value: hash,
+		},
+		{
+			Key:   "Body.Size",
+			Value: strconv.Itoa(len(masked)),
+		},
+	})
+}
@@ -0,0 +1,294 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	tracing struct {
+		init     InitFunc
+		initOnce sync.Once
+		shutdown func(context.Context) error
+	}
+
+	InitFunc func() func(context.Context) error
+
+	Config struct {
+		ServiceName     string
+		CaptureHeaders  []string
+		CaptureBodySize int
+		MaskKeys        []string
+		MaskPatterns    []string
+		// AsyncResponse marshals TraceHttpResponse data in the background.
+		// The data must not be mutated afterwards, and the span must be ended
+		// with EndSpan or EndHTTPServerSpan, which wait for the event; a plain
+		// span.End() may drop it.
+		AsyncResponse   bool
+		Disabled        bool
+		AttributePrefix string
+		NamespaceKeys   bool
+		StrictEvents    bool
+		PayloadStore    PayloadStore
+		OffloadBodySize int
+		AuditHook       AuditHook
+		URLPathRules    []URLPathRule
+		Routes          []string
+		IDGenerator     sdktrace.IDGenerator
+		File            FileOutput
+		SampleRatio     float64
+		ForceSampleKey  string
+		ForceSampleIDs  []string
+	}
+
+	KeyValue struct {
+		Key   string
+		Value string
+	}
+
+	Itf interface {
+		InitTracer() func(context.Context) error
+		CreateSpan(ctx context.Context, name string, err error, opts ...SpanTypeOption) (context.Context, trace.Span)
+		EndSpan(span trace.Span)
+		SetErrorSpan(span trace.Span, err error)
+		SetAttributes(span trace.Span, attributes []KeyValue)
+		AddEvent(span trace.Span, name string, attributes []KeyValue)
+		TraceHttpRequest(ctx context.Context, token, userId, queryParam, payload string)
+		TraceHttpResponse(ctx context.Context, code int, message string, data interface{}, errors interface{})
+		TraceGrpcRequest(ctx context.Context, fullMethod string, req interface{})
+		TraceGrpcResponse(ctx context.Context, resp interface{}, err error)
+		AddAuditEvent(ctx context.Context, actor, action, resource string, before, after interface{})
+		Stats() Stats
+	}
+)
+
+type settings struct {
+	tracer          trace.Tracer
+	captureHeaders  []string
+	captureBodySize int
+	asyncResponse   bool
+	attributePrefix string
+	namespaceKeys   bool
+	strictEvents    bool
+	payloadStore    PayloadStore
+	offloadBodySize int
+	auditHook       AuditHook
+}
+
+var (
+	defaultSettings = &settings{
+		tracer: otelapi.Tracer("github.com/elraghifary/go-modules/v1/trace/otel"),
+	}
+	currentSettings atomic.Value
+
+	instance       *tracing
+	instanceConfig string
+	newOnce        sync.Once
+)
+
+func loadSettings() *settings {
+	if s, ok := currentSettings.Load().(*settings); ok {
+		return s
+	}
+
+	return defaultSettings
+}
+
+func New(cfg Config, init InitFunc) Itf {
+	config := fmt.Sprintf("%#v", cfg)
+
+	first := false
+	newOnce.Do(func() {
+		first = true
+		instanceConfig = config
+		currentSettings.Store(&settings{
+			tracer:          otelapi.Tracer(cfg.ServiceName),
+			captureHeaders:  cfg.CaptureHeaders,
+			captureBodySize: cfg.CaptureBodySize,
+			asyncResponse:   cfg.AsyncResponse,
+			attributePrefix: cfg.AttributePrefix,
+			namespaceKeys:   cfg.NamespaceKeys,
+			strictEvents:    cfg.StrictEvents,
+			payloadStore:    cfg.PayloadStore,
+			offloadBodySize: cfg.OffloadBodySize,
+			auditHook:       cfg.AuditHook,
+		})
+		SetEnabled(!cfg.Disabled)
+		mask.AddKeys(cfg.MaskKeys...)
+		if err := mask.AddPatterns(cfg.MaskPatterns...); err != nil {
+			log.Printf("Invalid mask pattern: %v", err)
+		}
+		if err := AddURLPathRules(cfg.URLPathRules...); err != nil {
+			log.Printf("Invalid URL path rule: %v", err)
+		}
+		RegisterRoutes(cfg.Routes...)
+
+		instance = &tracing{
+			init: init,
+		}
+	})
+
+	if !first && config != instanceConfig {
+		log.Printf("Tracing already initialized, ignoring different config passed to New for service %q", cfg.ServiceName)
+	}
+
+	return instance
+}
+
+func Tracer() trace.Tracer {
+	return loadSettings().tracer
+}
+
+func (t *tracing) InitTracer() func(context.Context) error {
+	t.initOnce.Do(func() {
+		if !Enabled() {
+			t.shutdown = func(context.Context) error { return nil }
+			return
+		}
+
+		t.shutdown = t.init()
+	})
+
+	return t.shutdown
+}
+
+func (t *tracing) CreateSpan(ctx context.Context, spanName string, err error, opts ...SpanTypeOption) (context.Context, trace.Span) {
+	if !Enabled() {
+		return ctx, noopSpan
+	}
+
+	spanTypeConfig := spanTypeConfig{
+		SpanType:         Unspecified,
+		DatabasePlatform: "",
+		ExternalURL:      "",
+	}
+	for _, opt := range opts {
+		spanTypeConfig = opt.apply(spanTypeConfig)
+	}
+
+	options := []trace.SpanStartOption{
+		trace.WithSpanKind(spanTypeMapper[spanTypeConfig.SpanType]),
+		trace.WithAttributes(getSpanTypeAttributes(&spanTypeConfig)...),
+	}
+	if links := linksFromContext(ctx); len(links) > 0 {
+		options = append(options, trace.WithLinks(links...))
+		ctx = context.WithValue(ctx, linkContextKey{}, []trace.Link(nil))
+	}
+	if !spanTypeConfig.StartTime.IsZero() {
+		options = append(options, trace.WithTimestamp(spanTypeConfig.StartTime))
+	}
+
+	ctx, span := Tracer().Start(ctx, spanName, options...)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return ctx, span
+}
+
+func (t *tracing) EndSpan(span trace.Span) {
+	waitResponse(span)
+	span.End()
+}
+
+func (t *tracing) SetErrorSpan(span trace.Span, err error) {
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+}
+
+func (t *tracing) SetAttributes(span trace.Span, keyValue []KeyValue) {
+	SetSpanAttributes(span, keyValue)
+}
+
+func SetSpanAttributes(span trace.Span, keyValue []KeyValue) {
+	if !Enabled() || len(keyValue) == 0 || !span.IsRecording() {
+		return
+	}
+
+	attributes := getAttributes(keyValue)
+	span.SetAttributes(*attributes...)
+	putAttributes(attributes)
+}
+
+func (t *tracing) AddEvent(span trace.Span, name string, keyValue []KeyValue) {
+	AddSpanEvent(span, name, keyValue)
+}
+
+func AddSpanEvent(span trace.Span, name string, keyValue []KeyValue) {
+	if !Enabled() || !span.IsRecording() {
+		return
+	}
+
+	attributes := getAttributes(keyValue)
+	if err := validateEvent(name, keyValue); err != nil {
+		if loadSettings().strictEvents {
+			log.Printf("Rejected span event: %v", err)
+			putAttributes(attributes)
+			return
+		}
+		*attributes = append(*attributes, attribute.String(eventSchemaViolationKey, err.Error()))
+	}
+	span.AddEvent(name, trace.WithAttributes(*attributes...))
+	putAttributes(attributes)
+}
+
+func (t *tracing) TraceHttpRequest(ctx context.Context, token, userId, queryParam, payload string) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+
+	keyValueEvent := []KeyValue{
+		{
+			Key:   "Token",
+			Value: token,
+		},
+		{
+			Key:   "Query Param",
+			Value: queryParam,
+		},
+		{
+			Key:   "Payload",
+			Value: payload,
+		},
+	}
+	t.AddEvent(span, "Request", keyValueEvent)
+
+	keyValueAttributes := []KeyValue{
+		{
+			Key:   "userId",
+			Value: userId,
+		},
+	}
+	t.SetAttributes(span, keyValueAttributes)
+}
+
+func (t *tracing) TraceHttpResponse(ctx context.Context, code int, message string, data interface{}, errors interface{}) {
+	if !Enabled() {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	if loadSettings().asyncResponse {
+		traceResponseAsync(span, code, message, data, errors)
+		return
+	}
+
+	traceResponse(span, code, message, data, errors)
+}
@@ -0,0 +1,151 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrPoolClosed = errors.New("worker pool is closed")
+
+type (
+	PoolOption interface {
+		apply(poolConfig) poolConfig
+	}
+
+	poolConfig struct {
+		Name       string
+		QueueSize  int
+		LinkedJobs bool
+	}
+
+	poolOption func(poolConfig) poolConfig
+
+	WorkerPool struct {
+		config poolConfig
+		jobs   chan poolJob
+		wg     sync.WaitGroup
+		mu     sync.RWMutex
+		closed bool
+	}
+
+	poolJob struct {
+		ctx        context.Context
+		name       string
+		fn         func(ctx context.Context) error
+		enqueuedAt time.Time
+	}
+)
+
+func (fn poolOption) apply(config poolConfig) poolConfig {
+	return fn(config)
+}
+
+func WithPoolName(name string) PoolOption {
+	return poolOption(func(config poolConfig) poolConfig {
+		config.Name = name
+		return config
+	})
+}
+
+func WithQueueSize(size int) PoolOption {
+	return poolOption(func(config poolConfig) poolConfig {
+		config.QueueSize = size
+		return config
+	})
+}
+
+func WithLinkedJobs() PoolOption {
+	return poolOption(func(config poolConfig) poolConfig {
+		config.LinkedJobs = true
+		return config
+	})
+}
+
+func NewWorkerPool(workers int, opts ...PoolOption) *WorkerPool {
+	config := poolConfig{Name: "worker_pool"}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	p := &WorkerPool{
+		config: config,
+		jobs:   make(chan poolJob, config.QueueSize),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+
+	return p
+}
+
+func (p *WorkerPool) Submit(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	select {
+	case p.jobs <- poolJob{ctx: ctx, name: name, fn: fn, enqueuedAt: time.Now()}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *WorkerPool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+func (p *WorkerPool) work() {
+	defer p.wg.Done()
+
+	for job := range p.jobs {
+		p.run(job)
+	}
+}
+
+func (p *WorkerPool) run(job poolJob) {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("worker_pool.name", p.config.Name),
+			attribute.Float64("worker_pool.queue_wait_ms", float64(time.Since(job.enqueuedAt).Microseconds())/1000),
+		),
+	}
+
+	ctx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(job.ctx))
+	if p.config.LinkedJobs {
+		ctx = context.Background()
+		opts = append(opts, trace.WithNewRoot(), trace.WithLinks(LinkFromContext(job.ctx)))
+	}
+
+	ctx, span := Tracer().Start(ctx, job.name, opts...)
+	defer span.End()
+	defer RecoverSpan(span)
+
+	if err := job.fn(ctx); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
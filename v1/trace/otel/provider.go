@@ -0,0 +1,169 @@
+package otel
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+type ProviderOption interface {
+	apply(providerConfig) providerConfig
+}
+
+type providerConfig struct {
+	Propagators []propagation.TextMapPropagator
+	IDGenerator sdktrace.IDGenerator
+	Sampler     sdktrace.Sampler
+	Attributes  []attribute.KeyValue
+}
+
+type providerOption func(providerConfig) providerConfig
+
+func (fn providerOption) apply(config providerConfig) providerConfig {
+	return fn(config)
+}
+
+func WithPropagators(propagators ...propagation.TextMapPropagator) ProviderOption {
+	return providerOption(func(config providerConfig) providerConfig {
+		config.Propagators = append(propagators, config.Propagators...)
+		return config
+	})
+}
+
+func WithIDGenerator(generator sdktrace.IDGenerator) ProviderOption {
+	return providerOption(func(config providerConfig) providerConfig {
+		config.IDGenerator = generator
+		return config
+	})
+}
+
+func WithSampler(sampler sdktrace.Sampler) ProviderOption {
+	return providerOption(func(config providerConfig) providerConfig {
+		config.Sampler = sampler
+		return config
+	})
+}
+
+func WithResourceAttributes(attributes ...attribute.KeyValue) ProviderOption {
+	return providerOption(func(config providerConfig) providerConfig {
+		config.Attributes = append(config.Attributes, attributes...)
+		return config
+	})
+}
+
+func InitProvider(serviceName string, exporter sdktrace.SpanExporter, opts ...ProviderOption) func(context.Context) error {
+	config := providerConfig{
+		Propagators: []propagation.TextMapPropagator{
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		},
+	}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	resources, err := resource.New(
+		context.Background(),
+		resource.WithAttributes(
+			append([]attribute.KeyValue{
+				attribute.String("service.name", serviceName),
+				attribute.String("library.language", "go"),
+			}, config.Attributes...)...,
+		),
+	)
+	if err != nil {
+		log.Fatalf("Could not set resources: %v", err)
+	}
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithSpanProcessor(statsProcessor{}),
+		sdktrace.WithBatcher(statsExporter{SpanExporter: exporter}),
+		sdktrace.WithResource(resources),
+	}
+	if config.IDGenerator != nil {
+		options = append(options, sdktrace.WithIDGenerator(config.IDGenerator))
+	}
+	if config.Sampler != nil {
+		options = append(options, sdktrace.WithSampler(config.Sampler))
+	}
+
+	otelapi.SetTracerProvider(sdktrace.NewTracerProvider(options...))
+	otelapi.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(config.Propagators...))
+
+	return exporter.Shutdown
+}
+
+func InitConfigProvider(cfg Config, exporter sdktrace.SpanExporter, opts ...ProviderOption) func(context.Context) error {
+	switch {
+	case exporter == nil:
+		exporter = NewFileExporter(cfg.File)
+	case cfg.File.Path != "":
+		exporter = NewMultiExporter(exporter, NewFileExporter(cfg.File))
+	}
+
+	return InitProvider(cfg.ServiceName, exporter, append(cfg.providerOptions(), opts...)...)
+}
+
+func (cfg Config) providerOptions() []ProviderOption {
+	var opts []ProviderOption
+	if cfg.IDGenerator != nil {
+		opts = append(opts, WithIDGenerator(cfg.IDGenerator))
+	}
+
+	sampler := NewRatioSampler(cfg.SampleRatio)
+	if cfg.ForceSampleKey != "" && len(cfg.ForceSampleIDs) > 0 {
+		sampler = NewForcedSampler(sampler, cfg.ForceSampleKey, cfg.ForceSampleIDs...)
+	}
+	opts = append(opts, WithSampler(sampler))
+
+	return opts
+}
+
+func NewOTLPClient(collectorURL, insecure, protocol string, headers map[string]string) otlptrace.Client {
+	if strings.HasPrefix(collectorURL, "unix://") {
+		return otlptracegrpc.NewClient(
+			otlptracegrpc.WithInsecure(),
+			otlptracegrpc.WithEndpoint(collectorURL),
+			otlptracegrpc.WithHeaders(headers),
+		)
+	}
+
+	secure := strings.ToLower(insecure) == "false" || insecure == "0" || strings.ToLower(insecure) == "f"
+
+	if strings.ToLower(protocol) == "http" {
+		options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(collectorURL), otlptracehttp.WithHeaders(headers)}
+		if !secure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+
+		return otlptracehttp.NewClient(options...)
+	}
+
+	var secureOption otlptracegrpc.Option
+	if secure {
+		secureOption = otlptracegrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	} else {
+		secureOption = otlptracegrpc.WithInsecure()
+	}
+
+	options := []otlptracegrpc.Option{
+		secureOption,
+		otlptracegrpc.WithEndpoint(collectorURL),
+		otlptracegrpc.WithHeaders(headers),
+	}
+	if strings.HasPrefix(collectorURL, "dns:///") {
+		options = append(options, otlptracegrpc.WithServiceConfig(roundRobinServiceConfig))
+	}
+
+	return otlptracegrpc.NewClient(options...)
+}
@@ -0,0 +1,211 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var spanKinds = map[string]trace.SpanKind{
+	trace.SpanKindInternal.String(): trace.SpanKindInternal,
+	trace.SpanKindServer.String():   trace.SpanKindServer,
+	trace.SpanKindClient.String():   trace.SpanKindClient,
+	trace.SpanKindProducer.String(): trace.SpanKindProducer,
+	trace.SpanKindConsumer.String(): trace.SpanKindConsumer,
+}
+
+var statusCodes = map[string]codes.Code{
+	codes.Unset.String(): codes.Unset,
+	codes.Error.String(): codes.Error,
+	codes.Ok.String():    codes.Ok,
+}
+
+func ReplayFile(ctx context.Context, path string, exporter sdktrace.SpanExporter) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return Replay(ctx, file, exporter)
+}
+
+func Replay(ctx context.Context, r io.Reader, exporter sdktrace.SpanExporter) error {
+	spans, err := ReadSpans(r)
+	if err != nil {
+		return err
+	}
+
+	return exporter.ExportSpans(ctx, spans.Snapshots())
+}
+
+func ReadSpans(r io.Reader) (tracetest.SpanStubs, error) {
+	var spans tracetest.SpanStubs
+
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	for {
+		var record spanRecord
+		if err := decoder.Decode(&record); err == io.EOF {
+			return spans, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		span, err := record.stub()
+		if err != nil {
+			return nil, fmt.Errorf("span %s: %w", record.SpanID, err)
+		}
+		spans = append(spans, span)
+	}
+}
+
+func (record spanRecord) stub() (tracetest.SpanStub, error) {
+	traceID, err := trace.TraceIDFromHex(record.TraceID)
+	if err != nil {
+		return tracetest.SpanStub{}, err
+	}
+
+	spanID, err := trace.SpanIDFromHex(record.SpanID)
+	if err != nil {
+		return tracetest.SpanStub{}, err
+	}
+
+	stub := tracetest.SpanStub{
+		Name: record.Name,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+		SpanKind:   spanKinds[record.Kind],
+		StartTime:  record.StartTime,
+		EndTime:    record.EndTime,
+		Attributes: attributesOf(record.Attributes),
+		Status: sdktrace.Status{
+			Code:        statusCodes[record.StatusCode],
+			Description: record.StatusDesc,
+		},
+		Resource: resource.NewSchemaless(attributesOf(record.Resource)...),
+		InstrumentationLibrary: instrumentation.Scope{
+			Name:    record.Scope,
+			Version: record.ScopeVersion,
+		},
+	}
+
+	if record.ParentSpanID != "" {
+		parentID, err := trace.SpanIDFromHex(record.ParentSpanID)
+		if err != nil {
+			return tracetest.SpanStub{}, err
+		}
+		stub.Parent = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     parentID,
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+	}
+
+	for _, event := range record.Events {
+		stub.Events = append(stub.Events, sdktrace.Event{
+			Name:       event.Name,
+			Time:       event.Time,
+			Attributes: attributesOf(event.Attributes),
+		})
+	}
+
+	for _, link := range record.Links {
+		linkTraceID, err := trace.TraceIDFromHex(link.TraceID)
+		if err != nil {
+			return tracetest.SpanStub{}, err
+		}
+
+		linkSpanID, err := trace.SpanIDFromHex(link.SpanID)
+		if err != nil {
+			return tracetest.SpanStub{}, err
+		}
+
+		stub.Links = append(stub.Links, sdktrace.Link{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: linkTraceID,
+				SpanID:  linkSpanID,
+				Remote:  true,
+			}),
+			Attributes: attributesOf(link.Attributes),
+		})
+	}
+
+	return stub, nil
+}
+
+func attributesOf(records []attributeRecord) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(records))
+	for _, record := range records {
+		key := attribute.Key(record.Key)
+
+		switch record.Type {
+		case attribute.BOOL.String():
+			value, _ := record.Value.(bool)
+			attributes = append(attributes, key.Bool(value))
+		case attribute.INT64.String():
+			attributes = append(attributes, key.Int64(int64Of(record.Value)))
+		case attribute.FLOAT64.String():
+			attributes = append(attributes, key.Float64(float64Of(record.Value)))
+		case attribute.BOOLSLICE.String():
+			values, _ := record.Value.([]interface{})
+			slice := make([]bool, 0, len(values))
+			for _, value := range values {
+				v, _ := value.(bool)
+				slice = append(slice, v)
+			}
+			attributes = append(attributes, key.BoolSlice(slice))
+		case attribute.INT64SLICE.String():
+			values, _ := record.Value.([]interface{})
+			slice := make([]int64, 0, len(values))
+			for _, value := range values {
+				slice = append(slice, int64Of(value))
+			}
+			attributes = append(attributes, key.Int64Slice(slice))
+		case attribute.FLOAT64SLICE.String():
+			values, _ := record.Value.([]interface{})
+			slice := make([]float64, 0, len(values))
+			for _, value := range values {
+				slice = append(slice, float64Of(value))
+			}
+			attributes = append(attributes, key.Float64Slice(slice))
+		case attribute.STRINGSLICE.String():
+			values, _ := record.Value.([]interface{})
+			slice := make([]string, 0, len(values))
+			for _, value := range values {
+				slice = append(slice, fmt.Sprint(value))
+			}
+			attributes = append(attributes, key.StringSlice(slice))
+		default:
+			attributes = append(attributes, key.String(fmt.Sprint(record.Value)))
+		}
+	}
+
+	return attributes
+}
+
+func int64Of(value interface{}) int64 {
+	number, _ := value.(json.Number)
+	v, _ := number.Int64()
+	return v
+}
+
+func float64Of(value interface{}) float64 {
+	number, _ := value.(json.Number)
+	v, _ := number.Float64()
+	return v
+}
@@ -0,0 +1,100 @@
+package otel
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type pendingResponse struct {
+	wg    sync.WaitGroup
+	count int
+}
+
+var (
+	pendingMu        sync.Mutex
+	pendingResponses = map[trace.SpanID]*pendingResponse{}
+)
+
+func responseKeyValues(code int, message string, data interface{}, errors interface{}) ([]KeyValue, bool) {
+	dataString, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("failed to marshal response data: %v", err)
+		return nil, false
+	}
+
+	errorsString, err := json.Marshal(errors)
+	if err != nil {
+		log.Printf("failed to marshal response errors: %v", err)
+		return nil, false
+	}
+
+	return []KeyValue{
+		{
+			Key:   "Code",
+			Value: strconv.Itoa(code),
+		},
+		{
+			Key:   "Message",
+			Value: message,
+		},
+		{
+			Key:   "Data",
+			Value: string(dataString),
+		},
+		{
+			Key:   "Errors",
+			Value: string(errorsString),
+		},
+	}, true
+}
+
+func traceResponse(span trace.Span, code int, message string, data interface{}, errors interface{}) {
+	keyValue, ok := responseKeyValues(code, message, data, errors)
+	if !ok {
+		return
+	}
+
+	AddSpanEvent(span, "Response", keyValue)
+}
+
+func traceResponseAsync(span trace.Span, code int, message string, data interface{}, errors interface{}) {
+	spanID := span.SpanContext().SpanID()
+
+	pendingMu.Lock()
+	pending, ok := pendingResponses[spanID]
+	if !ok {
+		pending = &pendingResponse{}
+		pendingResponses[spanID] = pending
+	}
+	pending.count++
+	pending.wg.Add(1)
+	pendingMu.Unlock()
+
+	go func() {
+		defer func() {
+			pendingMu.Lock()
+			pending.count--
+			if pending.count == 0 && pendingResponses[spanID] == pending {
+				delete(pendingResponses, spanID)
+			}
+			pendingMu.Unlock()
+			pending.wg.Done()
+		}()
+
+		traceResponse(span, code, message, data, errors)
+	}()
+}
+
+func waitResponse(span trace.Span) {
+	pendingMu.Lock()
+	pending, ok := pendingResponses[span.SpanContext().SpanID()]
+	pendingMu.Unlock()
+
+	if ok {
+		pending.wg.Wait()
+	}
+}
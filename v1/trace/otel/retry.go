@@ -0,0 +1,52 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	retryContextKey struct{}
+
+	retryAttempt struct {
+		name        string
+		spanContext trace.SpanContext
+	}
+)
+
+func TraceRetry(ctx context.Context, name string, attempt, maxAttempts int, fn func(context.Context) error) (context.Context, error) {
+	if !Enabled() {
+		return ctx, fn(ctx)
+	}
+
+	options := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.Int("retry.attempt", attempt),
+			attribute.Int("retry.max_attempts", maxAttempts),
+			attribute.Bool("retry.is_retry", attempt > 1),
+		),
+	}
+	if previous, ok := ctx.Value(retryContextKey{}).(retryAttempt); ok && previous.name == name && attempt > 1 {
+		options = append(options, trace.WithLinks(trace.Link{
+			SpanContext: previous.spanContext,
+			Attributes:  []attribute.KeyValue{attribute.String("retry.link", "previous_attempt")},
+		}))
+	}
+
+	attemptCtx, span := Tracer().Start(ctx, name, options...)
+	defer span.End()
+
+	err := fn(attemptCtx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+	if err == nil || attempt >= maxAttempts {
+		span.SetAttributes(attribute.Bool("retry.final", true))
+	}
+
+	return context.WithValue(ctx, retryContextKey{}, retryAttempt{name: name, spanContext: span.SpanContext()}), err
+}
@@ -0,0 +1,79 @@
+package otel
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+type route struct {
+	pattern  string
+	segments []string
+	static   int
+}
+
+var (
+	routesMu sync.RWMutex
+	routes   []route
+)
+
+func RegisterRoutes(patterns ...string) {
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	for _, pattern := range patterns {
+		r := route{pattern: pattern, segments: splitPath(pattern)}
+		for _, segment := range r.segments {
+			if !isRouteParam(segment) {
+				r.static++
+			}
+		}
+		routes = append(routes, r)
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].static > routes[j].static
+	})
+}
+
+func Route(path string) string {
+	routesMu.RLock()
+	defer routesMu.RUnlock()
+
+	if len(routes) == 0 {
+		return ""
+	}
+
+	segments := splitPath(path)
+	for _, r := range routes {
+		if r.match(segments) {
+			return r.pattern
+		}
+	}
+
+	return ""
+}
+
+func (r route) match(segments []string) bool {
+	for i, segment := range r.segments {
+		if segment == "*" {
+			return true
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if !isRouteParam(segment) && segment != segments[i] {
+			return false
+		}
+	}
+
+	return len(segments) == len(r.segments)
+}
+
+func isRouteParam(segment string) bool {
+	return segment == "*" || (strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"))
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
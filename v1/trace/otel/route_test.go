@@ -0,0 +1,44 @@
+package otel
+
+import "testing"
+
+func TestRoute(t *testing.T) {
+	routesMu.Lock()
+	saved := routes
+	routes = nil
+	routesMu.Unlock()
+	t.Cleanup(func() {
+		routesMu.Lock()
+		routes = saved
+		routesMu.Unlock()
+	})
+
+	if got := Route("/users/42"); got != "" {
+		t.Fatalf("Route with no registered routes = %q, want empty", got)
+	}
+
+	RegisterRoutes("/users/{id}", "/users/me", "/files/*", "/users/{id}/orders/{orderId}", "/")
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"param", "/users/42", "/users/{id}"},
+		{"static wins over param", "/users/me", "/users/me"},
+		{"nested params", "/users/42/orders/7", "/users/{id}/orders/{orderId}"},
+		{"wildcard", "/files/a/b/c.txt", "/files/*"},
+		{"trailing slash", "/users/42/", "/users/{id}"},
+		{"root", "/", "/"},
+		{"too long", "/users/42/orders", ""},
+		{"unmatched", "/health", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Route(tt.path); got != tt.want {
+				t.Errorf("Route(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package otel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const forcedSamplingKey = "sampling.forced"
+
+type forcedSampler struct {
+	root   sdktrace.Sampler
+	key    string
+	values map[string]struct{}
+}
+
+func NewForcedSampler(root sdktrace.Sampler, key string, values ...string) sdktrace.Sampler {
+	allowlist := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		allowlist[value] = struct{}{}
+	}
+
+	return &forcedSampler{root: root, key: key, values: allowlist}
+}
+
+func NewRatioSampler(ratio float64) sdktrace.Sampler {
+	if ratio <= 0 || ratio >= 1 {
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))
+}
+
+func (s *forcedSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	value := baggage.FromContext(p.ParentContext).Member(s.key).Value()
+	if _, ok := s.values[value]; ok && value != "" {
+		return sdktrace.SamplingResult{
+			Decision:   sdktrace.RecordAndSample,
+			Attributes: []attribute.KeyValue{attribute.String(forcedSamplingKey, s.key+"="+value)},
+			Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+		}
+	}
+
+	return s.root.ShouldSample(p)
+}
+
+func (s *forcedSampler) Description() string {
+	return "ForcedSampler{" + s.key + "," + s.root.Description() + "}"
+}
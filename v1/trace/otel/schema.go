@@ -0,0 +1,91 @@
+package otel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	ValueType int
+
+	EventSchema struct {
+		Required []string
+		Types    map[string]ValueType
+	}
+)
+
+const (
+	StringValue ValueType = iota
+	IntValue
+	FloatValue
+	BoolValue
+)
+
+const eventSchemaViolationKey = "event.schema_violation"
+
+var (
+	eventSchemasMu sync.RWMutex
+	eventSchemas   = map[string]EventSchema{}
+)
+
+func RegisterEventSchema(name string, schema EventSchema) {
+	eventSchemasMu.Lock()
+	defer eventSchemasMu.Unlock()
+
+	eventSchemas[name] = schema
+}
+
+func validateEvent(name string, keyValue []KeyValue) error {
+	eventSchemasMu.RLock()
+	schema, ok := eventSchemas[name]
+	eventSchemasMu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	var problems []string
+	for _, key := range schema.Required {
+		if !hasKey(keyValue, key) {
+			problems = append(problems, "missing "+key)
+		}
+	}
+
+	for _, item := range keyValue {
+		valueType, ok := schema.Types[item.Key]
+		if ok && !valueType.valid(item.Value) {
+			problems = append(problems, "invalid "+item.Key)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("event %s: %s", name, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func hasKey(keyValue []KeyValue, key string) bool {
+	for _, item := range keyValue {
+		if item.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t ValueType) valid(value string) bool {
+	var err error
+	switch t {
+	case IntValue:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case FloatValue:
+		_, err = strconv.ParseFloat(value, 64)
+	case BoolValue:
+		_, err = strconv.ParseBool(value)
+	}
+
+	return err == nil
+}
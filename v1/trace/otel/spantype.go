@@ -1,6 +1,9 @@
-package signoz
+package otel
 
 import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -19,10 +22,11 @@ const (
 type DatabasePlatform string
 
 const (
-	Other   DatabasePlatform = "other_sql"
-	MySQL   DatabasePlatform = "mysql"
-	MariaDB DatabasePlatform = "mariadb"
-	Redis   DatabasePlatform = "redis"
+	Other      DatabasePlatform = "other_sql"
+	MySQL      DatabasePlatform = "mysql"
+	MariaDB    DatabasePlatform = "mariadb"
+	Redis      DatabasePlatform = "redis"
+	PostgreSQL DatabasePlatform = "postgresql"
 )
 
 type ExternalURL string
@@ -35,6 +39,7 @@ type spanTypeConfig struct {
 	SpanType         SpanType
 	DatabasePlatform DatabasePlatform
 	ExternalURL      ExternalURL
+	StartTime        time.Time
 }
 
 type config func(spanTypeConfig) spanTypeConfig
@@ -70,24 +75,24 @@ func ExternalCalls(externalURL ExternalURL) SpanTypeOption {
 	})
 }
 
-func getSpanTypeAttributes(spanTypeConfig *spanTypeConfig) []KeyValue {
+func WithStartTimestamp(startTime time.Time) SpanTypeOption {
+	return config(func(config spanTypeConfig) spanTypeConfig {
+		config.StartTime = startTime
+		return config
+	})
+}
+
+func getSpanTypeAttributes(spanTypeConfig *spanTypeConfig) []attribute.KeyValue {
 	if spanTypeConfig == nil {
 		return nil
 	}
 
-	attributes := []KeyValue{}
 	switch spanTypeConfig.SpanType {
 	case Internal:
-		attributes = append(attributes, KeyValue{
-			Key:   string(semconv.DBSystemMySQL.Key),
-			Value: string(spanTypeConfig.DatabasePlatform),
-		})
+		return []attribute.KeyValue{semconv.DBSystemKey.String(string(spanTypeConfig.DatabasePlatform))}
 	case Server:
-		attributes = append(attributes, KeyValue{
-			Key:   string(semconv.HTTPURLKey),
-			Value: string(spanTypeConfig.ExternalURL),
-		})
+		return []attribute.KeyValue{semconv.HTTPURLKey.String(SanitizeURL(string(spanTypeConfig.ExternalURL)))}
 	}
 
-	return attributes
+	return nil
 }
@@ -0,0 +1,138 @@
+package otel
+
+import (
+	"regexp"
+	"strings"
+)
+
+var inListPattern = regexp.MustCompile(`(?i)(\bIN\s*\()\s*\?(?:\s*,\s*\?)*\s*\)`)
+
+func SanitizeSQL(query string) string {
+	var builder strings.Builder
+	builder.Grow(len(query))
+
+	for i := 0; i < len(query); {
+		c := query[i]
+
+		switch {
+		case c == '\'':
+			i = skipQuoted(query, i, '\'')
+			builder.WriteByte('?')
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				i = len(query)
+				continue
+			}
+			i += end
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+				continue
+			}
+			i += end + 4
+		case c == '$' && dollarQuote(query, i) != "":
+			tag := dollarQuote(query, i)
+			end := strings.Index(query[i+len(tag):], tag)
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += len(tag) + end + len(tag)
+			}
+			builder.WriteByte('?')
+		case c == '"' || c == '`':
+			end := skipQuoted(query, i, c)
+			builder.WriteString(query[i:end])
+			i = end
+		case isDigit(c):
+			i = skipNumber(query, i)
+			builder.WriteByte('?')
+		case isIdentifier(c):
+			end := i
+			for end < len(query) && isIdentifier(query[end]) {
+				end++
+			}
+			if word := strings.ToUpper(query[i:end]); word == "TRUE" || word == "FALSE" {
+				builder.WriteByte('?')
+			} else {
+				builder.WriteString(query[i:end])
+			}
+			i = end
+		default:
+			builder.WriteByte(c)
+			i++
+		}
+	}
+
+	return collapseLists(strings.TrimSpace(builder.String()))
+}
+
+func SQLOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(fields[0])
+}
+
+func skipQuoted(query string, i int, quote byte) int {
+	for i++; i < len(query); i++ {
+		if query[i] == '\\' && quote == '\'' {
+			i++
+			continue
+		}
+		if query[i] == quote {
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+
+	return len(query)
+}
+
+func skipNumber(query string, i int) int {
+	if strings.HasPrefix(query[i:], "0x") || strings.HasPrefix(query[i:], "0X") {
+		i += 2
+		for i < len(query) && strings.IndexByte("0123456789abcdefABCDEF", query[i]) >= 0 {
+			i++
+		}
+		return i
+	}
+
+	for i < len(query) && (isDigit(query[i]) || query[i] == '.' || query[i] == 'e' || query[i] == 'E') {
+		i++
+	}
+
+	return i
+}
+
+func dollarQuote(query string, i int) string {
+	for end := i + 1; end < len(query); end++ {
+		c := query[end]
+		if c == '$' {
+			return query[i : end+1]
+		}
+		if !(c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (end > i+1 && isDigit(c))) {
+			return ""
+		}
+	}
+
+	return ""
+}
+
+func collapseLists(query string) string {
+	return inListPattern.ReplaceAllString(query, "${1}?)")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || c == '$' || c == '@' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,35 @@
+package otel
+
+import "testing"
+
+func TestSanitizeSQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"string literal", "SELECT * FROM users WHERE email = 'a@b.com'", "SELECT * FROM users WHERE email = ?"},
+		{"numeric literals", "SELECT * FROM t WHERE id = 42 AND score > 1.5e3 AND flags = 0xFF", "SELECT * FROM t WHERE id = ? AND score > ? AND flags = ?"},
+		{"boolean literal", "UPDATE t SET active = TRUE WHERE deleted = false", "UPDATE t SET active = ? WHERE deleted = ?"},
+		{"doubled quote", "SELECT 1 FROM t WHERE name = 'O''Brien' AND x = 1", "SELECT ? FROM t WHERE name = ? AND x = ?"},
+		{"backslash quote", `SELECT * FROM t WHERE name = 'it\'s' AND id = 1`, "SELECT * FROM t WHERE name = ? AND id = ?"},
+		{"line comment", "SELECT * FROM t -- secret 'x'\nWHERE id = 1", "SELECT * FROM t \nWHERE id = ?"},
+		{"block comment", "SELECT /* token=abc */ * FROM t", "SELECT  * FROM t"},
+		{"quoted identifiers", "SELECT \"user_id\", `name` FROM t", "SELECT \"user_id\", `name` FROM t"},
+		{"in list", "SELECT * FROM t WHERE id IN (1, 2, 3)", "SELECT * FROM t WHERE id IN (?)"},
+		{"in list of strings", "SELECT * FROM t WHERE id in ('a','b')", "SELECT * FROM t WHERE id in (?)"},
+		{"values tuple keeps shape", "INSERT INTO t (a, b, c) VALUES (1, 'x', 2)", "INSERT INTO t (a, b, c) VALUES (?, ?, ?)"},
+		{"dollar quoted", "SELECT $$secret$$, $tag$hidden$tag$ FROM t", "SELECT ?, ? FROM t"},
+		{"positional placeholders", "SELECT * FROM t WHERE id = $1 AND name = $2", "SELECT * FROM t WHERE id = $1 AND name = $2"},
+		{"identifier with digits", "SELECT col1 FROM table2", "SELECT col1 FROM table2"},
+		{"unterminated string", "SELECT * FROM t WHERE a = 'open", "SELECT * FROM t WHERE a = ?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeSQL(tt.query); got != tt.want {
+				t.Errorf("SanitizeSQL(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package otel
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type Stats struct {
+	SpansStarted    int64
+	SpansEnded      int64
+	SpansExported   int64
+	SpansFailed     int64
+	LastExportError error
+	LastExportTime  time.Time
+}
+
+type statsProcessor struct{}
+
+type statsExporter struct {
+	sdktrace.SpanExporter
+}
+
+var (
+	spansStarted  int64
+	spansEnded    int64
+	spansExported int64
+	spansFailed   int64
+
+	lastExportMu    sync.Mutex
+	lastExportError error
+	lastExportTime  time.Time
+)
+
+func GetStats() Stats {
+	stats := Stats{
+		SpansStarted:  atomic.LoadInt64(&spansStarted),
+		SpansEnded:    atomic.LoadInt64(&spansEnded),
+		SpansExported: atomic.LoadInt64(&spansExported),
+		SpansFailed:   atomic.LoadInt64(&spansFailed),
+	}
+
+	lastExportMu.Lock()
+	stats.LastExportError = lastExportError
+	stats.LastExportTime = lastExportTime
+	lastExportMu.Unlock()
+
+	return stats
+}
+
+func (t *tracing) Stats() Stats {
+	return GetStats()
+}
+
+func (statsProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	atomic.AddInt64(&spansStarted, 1)
+}
+
+func (statsProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		atomic.AddInt64(&spansEnded, 1)
+	}
+}
+
+func (statsProcessor) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func (statsProcessor) ForceFlush(ctx context.Context) error {
+	return nil
+}
+
+func (e statsExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	err := e.SpanExporter.ExportSpans(ctx, spans)
+	if err != nil {
+		atomic.AddInt64(&spansFailed, int64(len(spans)))
+	} else {
+		atomic.AddInt64(&spansExported, int64(len(spans)))
+	}
+
+	lastExportMu.Lock()
+	lastExportError = err
+	lastExportTime = time.Now()
+	lastExportMu.Unlock()
+
+	return err
+}
@@ -0,0 +1,117 @@
+package tracetestutil
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type Recorder struct {
+	exporter *tracetest.InMemoryExporter
+}
+
+var (
+	exporter     = tracetest.NewInMemoryExporter()
+	providerOnce sync.Once
+)
+
+func NewRecorder() *Recorder {
+	providerOnce.Do(func() {
+		otelapi.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	})
+	exporter.Reset()
+
+	return &Recorder{exporter: exporter}
+}
+
+func (r *Recorder) Spans() tracetest.SpanStubs {
+	return r.exporter.GetSpans()
+}
+
+func (r *Recorder) Reset() {
+	r.exporter.Reset()
+}
+
+func FindSpan(spans tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, span := range spans {
+		if span.Name == name {
+			return span, true
+		}
+	}
+
+	return tracetest.SpanStub{}, false
+}
+
+func AssertSpanExists(t testing.TB, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+
+	span, ok := FindSpan(spans, name)
+	if !ok {
+		t.Fatalf("span %q not found in %d recorded spans", name, len(spans))
+	}
+
+	return span
+}
+
+func AssertChildOf(t testing.TB, spans tracetest.SpanStubs, child, parent string) {
+	t.Helper()
+
+	childSpan := AssertSpanExists(t, spans, child)
+	parentSpan := AssertSpanExists(t, spans, parent)
+
+	if childSpan.Parent.SpanID() != parentSpan.SpanContext.SpanID() {
+		t.Errorf("span %q parent is %s, want %q (%s)", child, childSpan.Parent.SpanID(), parent, parentSpan.SpanContext.SpanID())
+	}
+}
+
+func AssertAttribute(t testing.TB, spans tracetest.SpanStubs, name string, key string, value interface{}) {
+	t.Helper()
+
+	span := AssertSpanExists(t, spans, name)
+	for _, attr := range span.Attributes {
+		if string(attr.Key) != key {
+			continue
+		}
+
+		if attr.Value != attributeOf(key, value).Value {
+			t.Errorf("span %q attribute %q is %v, want %v", name, key, attr.Value.AsInterface(), value)
+		}
+		return
+	}
+
+	t.Errorf("span %q has no attribute %q", name, key)
+}
+
+func AssertErrorStatus(t testing.TB, spans tracetest.SpanStubs, name string) {
+	t.Helper()
+
+	span := AssertSpanExists(t, spans, name)
+	if span.Status.Code != codes.Error {
+		t.Errorf("span %q status is %s, want %s", name, span.Status.Code, codes.Error)
+	}
+}
+
+func attributeOf(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	case []string:
+		return attribute.StringSlice(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
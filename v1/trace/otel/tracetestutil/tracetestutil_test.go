@@ -0,0 +1,86 @@
+package tracetestutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type recordingTB struct {
+	testing.TB
+	failures []string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Errorf(format string, args ...interface{}) {
+	tb.failures = append(tb.failures, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) Fatalf(format string, args ...interface{}) {
+	tb.failures = append(tb.failures, fmt.Sprintf(format, args...))
+}
+
+func recordSpans() *Recorder {
+	recorder := NewRecorder()
+
+	tracer := otelapi.Tracer("tracetestutil")
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttributes(attribute.String("user.id", "42"), attribute.Int("attempt", 2))
+	child.SetStatus(codes.Error, "boom")
+	child.RecordError(errors.New("boom"))
+	child.End()
+	parent.End()
+
+	return recorder
+}
+
+func TestNewRecorderRecordsAcrossRecorders(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		spans := recordSpans().Spans()
+		if len(spans) != 2 {
+			t.Fatalf("recorder %d got %d spans, want 2", i, len(spans))
+		}
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	spans := recordSpans().Spans()
+
+	AssertSpanExists(t, spans, "child")
+	AssertChildOf(t, spans, "child", "parent")
+	AssertAttribute(t, spans, "child", "user.id", "42")
+	AssertAttribute(t, spans, "child", "attempt", 2)
+	AssertErrorStatus(t, spans, "child")
+}
+
+func TestAssertionsFail(t *testing.T) {
+	spans := recordSpans().Spans()
+
+	tests := []struct {
+		name   string
+		assert func(tb testing.TB)
+	}{
+		{"missing span", func(tb testing.TB) { AssertSpanExists(tb, spans, "missing") }},
+		{"wrong parent", func(tb testing.TB) { AssertChildOf(tb, spans, "parent", "child") }},
+		{"wrong attribute value", func(tb testing.TB) { AssertAttribute(tb, spans, "child", "user.id", "43") }},
+		{"missing attribute", func(tb testing.TB) { AssertAttribute(tb, spans, "child", "missing", "x") }},
+		{"not an error", func(tb testing.TB) { AssertErrorStatus(tb, spans, "parent") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t}
+			tt.assert(tb)
+			if len(tb.failures) == 0 {
+				t.Errorf("expected assertion to fail")
+			}
+		})
+	}
+}
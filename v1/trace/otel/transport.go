@@ -0,0 +1,62 @@
+package otel
+
+import (
+	"net/http"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type transport struct {
+	rt http.RoundTripper
+}
+
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &transport{rt: rt}
+}
+
+func clientAttributes(r *http.Request) []attribute.KeyValue {
+	attributes := semconv.HTTPClientAttributesFromHTTPRequest(r)
+	for i, kv := range attributes {
+		if kv.Key == semconv.HTTPURLKey {
+			attributes[i] = semconv.HTTPURLKey.String(SanitizeURL(r.URL.String()))
+			return attributes
+		}
+	}
+
+	return append(attributes, semconv.HTTPURLKey.String(SanitizeURL(r.URL.String())))
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := Tracer().Start(
+		r.Context(),
+		"HTTP "+r.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(clientAttributes(r)...),
+	)
+
+	r = r.Clone(ctx)
+	otelapi.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+
+	resp, err := t.rt.RoundTrip(r)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		span.End()
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+	span.End()
+
+	return resp, nil
+}
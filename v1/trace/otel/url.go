@@ -0,0 +1,121 @@
+package otel
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+)
+
+type URLPathRule struct {
+	Pattern     string
+	Replacement string
+}
+
+type urlPathRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+var (
+	urlSecretKeys = map[string]struct{}{
+		"api_key":          {},
+		"apikey":           {},
+		"key":              {},
+		"sig":              {},
+		"signature":        {},
+		"client_secret":    {},
+		"x-amz-signature":  {},
+		"x-amz-credential": {},
+	}
+
+	urlPathRulesMu sync.RWMutex
+	urlPathRules   = []urlPathRule{
+		{re: regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`), replacement: "{uuid}"},
+		{re: regexp.MustCompile(`^[0-9]+$`), replacement: "{id}"},
+	}
+)
+
+func AddURLPathRules(rules ...URLPathRule) error {
+	compiled := make([]urlPathRule, 0, len(rules))
+	for _, rule := range rules {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return err
+		}
+		compiled = append(compiled, urlPathRule{re: re, replacement: rule.Replacement})
+	}
+
+	urlPathRulesMu.Lock()
+	urlPathRules = append(urlPathRules, compiled...)
+	urlPathRulesMu.Unlock()
+
+	return nil
+}
+
+func SanitizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return mask.String(rawURL)
+	}
+
+	u.User = nil
+	u.Fragment = ""
+
+	urlPathRulesMu.RLock()
+	rules := urlPathRules
+	urlPathRulesMu.RUnlock()
+
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		segments[i] = sanitizePathSegment(rules, segment)
+	}
+	path := strings.Join(segments, "/")
+
+	query := u.RawQuery
+	u.Path, u.RawPath, u.RawQuery = "", "", ""
+
+	sanitized := u.String() + path
+	if query != "" {
+		sanitized += "?" + sanitizeQuery(query)
+	}
+
+	return sanitized
+}
+
+func sanitizePathSegment(rules []urlPathRule, segment string) string {
+	if segment == "" {
+		return segment
+	}
+
+	for _, rule := range rules {
+		if rule.re.MatchString(segment) {
+			return rule.replacement
+		}
+	}
+
+	return segment
+}
+
+func sanitizeQuery(rawQuery string) string {
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		key := param
+		if j := strings.IndexByte(param, '='); j >= 0 {
+			key = param[:j]
+		}
+
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+
+		if _, ok := urlSecretKeys[strings.ToLower(name)]; ok || mask.IsKey(name) {
+			params[i] = key + "=" + mask.Value
+		}
+	}
+
+	return strings.Join(params, "&")
+}
@@ -0,0 +1,105 @@
+package signozamqp
+
+import (
+	"context"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tableCarrier amqp.Table
+
+func (c tableCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+func (c tableCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c tableCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+type DeliveryHandler func(ctx context.Context, delivery amqp.Delivery) error
+
+func PublishWithTrace(ctx context.Context, ch *amqp.Channel, exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error {
+	destination := exchange
+	if destination == "" {
+		destination = "(default)"
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		destination+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("rabbitmq"),
+			semconv.MessagingDestinationKey.String(exchange),
+			semconv.MessagingDestinationKindTopic,
+			attribute.String("messaging.rabbitmq.exchange", exchange),
+			semconv.MessagingRabbitmqRoutingKeyKey.String(routingKey),
+			semconv.MessagingOperationKey.String("send"),
+		),
+	)
+	defer span.End()
+
+	if msg.Headers == nil {
+		msg.Headers = amqp.Table{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, tableCarrier(msg.Headers))
+
+	err := ch.PublishWithContext(ctx, exchange, routingKey, mandatory, immediate, msg)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+func WrapHandler(queue string, handler DeliveryHandler) func(amqp.Delivery) {
+	return func(delivery amqp.Delivery) {
+		ctx := context.Background()
+		if delivery.Headers != nil {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, tableCarrier(delivery.Headers))
+		}
+
+		attributes := []attribute.KeyValue{
+			semconv.MessagingSystemKey.String("rabbitmq"),
+			semconv.MessagingDestinationKey.String(delivery.Exchange),
+			attribute.String("messaging.rabbitmq.exchange", delivery.Exchange),
+			semconv.MessagingRabbitmqRoutingKeyKey.String(delivery.RoutingKey),
+			semconv.MessagingOperationKey.String("process"),
+			attribute.String("messaging.rabbitmq.queue", queue),
+			attribute.Bool("messaging.rabbitmq.redelivered", delivery.Redelivered),
+		}
+		if delivery.MessageId != "" {
+			attributes = append(attributes, semconv.MessagingMessageIDKey.String(delivery.MessageId))
+		}
+
+		ctx, span := signoz.Tracer().Start(
+			ctx,
+			queue+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(attributes...),
+		)
+		defer span.End()
+
+		if err := handler(ctx, delivery); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package signozasynq
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const envelopePrefix = `{"_trace":`
+
+type (
+	envelope struct {
+		Trace   propagation.MapCarrier `json:"_trace"`
+		Payload json.RawMessage        `json:"_payload,omitempty"`
+		Bytes   []byte                 `json:"_bytes,omitempty"`
+	}
+
+	payloadContextKey struct{}
+)
+
+// NewTask wraps payload in a {"_trace":…,"_payload":…} envelope carrying the
+// trace context; JSON payloads are embedded byte for byte, other payloads go under
+// "_bytes" base64-encoded. Consumers must read the payload with Payload behind
+// Middleware. Tasks created with asynq.Unique are left unwrapped, and so are
+// not linked to the producer, because a per-call trace context would make
+// every payload unique.
+func NewTask(ctx context.Context, typename string, payload []byte, opts ...asynq.Option) *asynq.Task {
+	for _, opt := range opts {
+		if opt.Type() == asynq.UniqueOpt {
+			return asynq.NewTask(typename, payload, opts...)
+		}
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return asynq.NewTask(typename, payload, opts...)
+	}
+
+	metadata, err := json.Marshal(carrier)
+	if err != nil {
+		return asynq.NewTask(typename, payload, opts...)
+	}
+
+	field, value := `,"_payload":`, payload
+	if !json.Valid(payload) {
+		field = `,"_bytes":`
+		if value, err = json.Marshal(payload); err != nil {
+			return asynq.NewTask(typename, payload, opts...)
+		}
+	}
+
+	tracedPayload := make([]byte, 0, len(envelopePrefix)+len(metadata)+len(field)+len(value)+1)
+	tracedPayload = append(tracedPayload, envelopePrefix...)
+	tracedPayload = append(tracedPayload, metadata...)
+	tracedPayload = append(tracedPayload, field...)
+	tracedPayload = append(tracedPayload, value...)
+	tracedPayload = append(tracedPayload, '}')
+
+	return asynq.NewTask(typename, tracedPayload, opts...)
+}
+
+// Payload returns the task payload with the trace envelope removed.
+func Payload(ctx context.Context, task *asynq.Task) []byte {
+	if payload, ok := ctx.Value(payloadContextKey{}).([]byte); ok {
+		return payload
+	}
+
+	return task.Payload()
+}
+
+func unwrap(task *asynq.Task) (propagation.MapCarrier, []byte, bool) {
+	if !bytes.HasPrefix(task.Payload(), []byte(envelopePrefix)) {
+		return nil, nil, false
+	}
+
+	var e envelope
+	if err := json.Unmarshal(task.Payload(), &e); err != nil || e.Trace == nil {
+		return nil, nil, false
+	}
+
+	if e.Payload != nil {
+		return e.Trace, e.Payload, true
+	}
+
+	return e.Trace, e.Bytes, true
+}
+
+func Middleware(h asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		if carrier, payload, ok := unwrap(task); ok {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+			ctx = context.WithValue(ctx, payloadContextKey{}, payload)
+		}
+
+		queue, _ := asynq.GetQueueName(ctx)
+		taskID, _ := asynq.GetTaskID(ctx)
+		retryCount, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+
+		ctx, span := signoz.Tracer().Start(
+			ctx,
+			task.Type()+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKey.String("asynq"),
+				semconv.MessagingDestinationKey.String(queue),
+				semconv.MessagingOperationKey.String("process"),
+				semconv.MessagingMessageIDKey.String(taskID),
+				attribute.String("asynq.task.type", task.Type()),
+				attribute.Int("asynq.task.retry_count", retryCount),
+				attribute.Int("asynq.task.max_retry", maxRetry),
+			),
+		)
+		defer span.End()
+
+		err := h.ProcessTask(ctx, task)
+		switch {
+		case err == nil:
+			span.SetAttributes(attribute.String("asynq.task.outcome", "success"))
+		case errors.Is(err, asynq.SkipRetry):
+			span.SetAttributes(attribute.String("asynq.task.outcome", "skip_retry"))
+		case retryCount >= maxRetry:
+			span.SetAttributes(attribute.String("asynq.task.outcome", "archived"))
+		default:
+			span.SetAttributes(attribute.String("asynq.task.outcome", "retry"))
+		}
+
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+
+		return err
+	})
+}
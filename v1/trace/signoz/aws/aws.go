@@ -0,0 +1,112 @@
+package signozaws
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func AppendMiddlewares(cfg *aws.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, addMiddlewares)
+}
+
+func addMiddlewares(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SignozSpan", initializeMiddleware), middleware.Before); err != nil {
+		return err
+	}
+
+	if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("SignozPropagation", finalizeMiddleware), middleware.After); err != nil {
+		return err
+	}
+
+	return stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("SignozResponse", deserializeMiddleware), middleware.Before)
+}
+
+func initializeMiddleware(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		service+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCServiceKey.String(service),
+			semconv.RPCMethodKey.String(operation),
+			attribute.String("aws.region", awsmiddleware.GetRegion(ctx)),
+		),
+	)
+	defer span.End()
+
+	span.SetAttributes(parameterAttributes(in.Parameters)...)
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		span.SetAttributes(attribute.String("aws.request_id", requestID))
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return out, metadata, err
+}
+
+func finalizeMiddleware(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if req, ok := in.Request.(*smithyhttp.Request); ok {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
+
+func deserializeMiddleware(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+
+	if resp, ok := out.RawResponse.(*smithyhttp.Response); ok {
+		trace.SpanFromContext(ctx).SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	}
+
+	return out, metadata, err
+}
+
+var parameterAttributeKeys = map[string]string{
+	"Bucket":    "aws.s3.bucket",
+	"QueueUrl":  "aws.sqs.queue_url",
+	"TableName": "aws.dynamodb.table_name",
+	"TopicArn":  "aws.sns.topic_arn",
+}
+
+func parameterAttributes(parameters interface{}) []attribute.KeyValue {
+	value := reflect.ValueOf(parameters)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	value = value.Elem()
+
+	var attributes []attribute.KeyValue
+	for field, key := range parameterAttributeKeys {
+		fieldValue := value.FieldByName(field)
+		if !fieldValue.IsValid() || fieldValue.Kind() != reflect.Ptr || fieldValue.IsNil() {
+			continue
+		}
+
+		if s, ok := fieldValue.Elem().Interface().(string); ok {
+			attributes = append(attributes, attribute.String(key, s))
+		}
+	}
+
+	return attributes
+}
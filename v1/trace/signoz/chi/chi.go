@@ -0,0 +1,59 @@
+package signozchi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type AttributesFunc func(r *http.Request) []signoz.KeyValue
+
+func Middleware(attributesFunc AttributesFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			ctx, span := signoz.StartHTTPServerSpan(r, "")
+			defer span.End()
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			var body *signoz.BodyBuffer
+			if signoz.BodyCaptureEnabled() {
+				body = signoz.NewBodyBuffer()
+				ww.Tee(body)
+			}
+
+			r = r.WithContext(ctx)
+			next.ServeHTTP(ww, r)
+
+			if routeContext := chi.RouteContext(r.Context()); routeContext != nil {
+				if route := routeContext.RoutePattern(); route != "" {
+					span.SetName(signoz.HTTPServerSpanName(r.Method, route))
+					span.SetAttributes(semconv.HTTPRouteKey.String(route))
+				}
+			}
+
+			if attributesFunc != nil {
+				if attributes := attributesFunc(r); attributes != nil {
+					signoz.SetSpanAttributes(span, attributes)
+				}
+			}
+
+			if body != nil {
+				signoz.CaptureBody(span, "Response", ww.Header().Get("Content-Type"), body.Bytes())
+			}
+
+			statusCode := ww.Status()
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+
+			signoz.EndHTTPServerSpan(span, statusCode, ww.BytesWritten(), start)
+		})
+	}
+}
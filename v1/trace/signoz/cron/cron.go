@@ -0,0 +1,70 @@
+package signozcron
+
+import (
+	"context"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	JobFunc func(ctx context.Context) error
+
+	job struct {
+		cron     *cron.Cron
+		entryID  cron.EntryID
+		name     string
+		schedule string
+		fn       JobFunc
+	}
+)
+
+func Wrap(name string, fn JobFunc) cron.Job {
+	return &job{name: name, fn: fn}
+}
+
+func AddJob(c *cron.Cron, spec, name string, fn JobFunc) (cron.EntryID, error) {
+	j := &job{cron: c, name: name, schedule: spec, fn: fn}
+
+	entryID, err := c.AddJob(spec, j)
+	if err != nil {
+		return entryID, err
+	}
+	j.entryID = entryID
+
+	return entryID, nil
+}
+
+func (j *job) Run() {
+	attributes := []attribute.KeyValue{
+		attribute.String("cron.job.name", j.name),
+	}
+	if j.schedule != "" {
+		attributes = append(attributes, attribute.String("cron.schedule", j.schedule))
+	}
+	if j.cron != nil {
+		attributes = append(attributes, attribute.Int("cron.entry_id", int(j.entryID)))
+		if next := j.cron.Entry(j.entryID).Next; !next.IsZero() {
+			attributes = append(attributes, attribute.String("cron.next_run", next.Format(time.RFC3339)))
+		}
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		context.Background(),
+		j.name,
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+	defer signoz.RecoverSpan(span)
+
+	if err := j.fn(ctx); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
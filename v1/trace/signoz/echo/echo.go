@@ -0,0 +1,85 @@
+package signozecho
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type bodyWriter struct {
+	http.ResponseWriter
+	body *signoz.BodyBuffer
+}
+
+func (w *bodyWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.body.Write(b[:n])
+
+	return n, err
+}
+
+func (w *bodyWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *bodyWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not implement http.Hijacker")
+	}
+
+	return hijacker.Hijack()
+}
+
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			ctx, span := signoz.StartHTTPServerSpan(c.Request(), c.Path())
+			defer span.End()
+
+			c.SetRequest(c.Request().WithContext(ctx))
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(c.Response().Header()))
+
+			var body *signoz.BodyBuffer
+			if signoz.BodyCaptureEnabled() {
+				body = signoz.NewBodyBuffer()
+				c.Response().Writer = &bodyWriter{ResponseWriter: c.Response().Writer, body: body}
+			}
+
+			err := next(c)
+
+			statusCode := c.Response().Status
+			if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				span.RecordError(err)
+
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					statusCode = httpError.Code
+				} else if !c.Response().Committed {
+					statusCode = http.StatusInternalServerError
+				}
+			}
+
+			if body != nil {
+				signoz.CaptureBody(span, "Response", c.Response().Header().Get(echo.HeaderContentType), body.Bytes())
+			}
+
+			signoz.EndHTTPServerSpan(span, statusCode, int(c.Response().Size), start)
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package signozelasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxPrefixSize = 1 << 10
+
+type body struct {
+	io.ReadCloser
+	span   trace.Span
+	json   bool
+	prefix bytes.Buffer
+	once   sync.Once
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.json && b.prefix.Len() < maxPrefixSize {
+		remaining := maxPrefixSize - b.prefix.Len()
+		if remaining > n {
+			remaining = n
+		}
+		b.prefix.Write(p[:remaining])
+	}
+
+	if errors.Is(err, io.EOF) {
+		b.end(nil)
+	} else if err != nil {
+		b.end(err)
+	}
+
+	return n, err
+}
+
+func (b *body) Close() error {
+	err := b.ReadCloser.Close()
+	b.end(nil)
+
+	return err
+}
+
+func (b *body) end(err error) {
+	b.once.Do(func() {
+		if err != nil {
+			b.span.SetStatus(codes.Error, err.Error())
+			b.span.RecordError(err)
+		}
+		if took, ok := parseTook(b.prefix.Bytes()); ok {
+			b.span.SetAttributes(attribute.Int64("elasticsearch.took_ms", took))
+		}
+		b.span.End()
+	})
+}
+
+func parseTook(prefix []byte) (int64, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(prefix))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil || token != json.Delim('{') {
+		return 0, false
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return 0, false
+		}
+
+		if key != "took" {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return 0, false
+			}
+			continue
+		}
+
+		var took json.Number
+		if err := decoder.Decode(&took); err != nil {
+			return 0, false
+		}
+		value, err := took.Int64()
+
+		return value, err == nil
+	}
+
+	return 0, false
+}
@@ -0,0 +1,97 @@
+package signozelasticsearch
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type transport struct {
+	rt http.RoundTripper
+}
+
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &transport{rt: rt}
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	index, endpoint := parsePath(r.URL.Path)
+
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String("elasticsearch"),
+		semconv.DBOperationKey.String(endpoint),
+		semconv.HTTPMethodKey.String(r.Method),
+		semconv.HTTPURLKey.String(r.URL.Scheme + "://" + r.URL.Host + r.URL.Path),
+		semconv.NetPeerNameKey.String(r.URL.Hostname()),
+	}
+	if index != "" {
+		attributes = append(attributes, attribute.String("elasticsearch.index", index))
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		r.Context(),
+		"elasticsearch "+r.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	r = r.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+
+	resp, err := t.rt.RoundTrip(r)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		span.End()
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+
+	if resp.Body == nil || resp.Body == http.NoBody {
+		span.End()
+		return resp, nil
+	}
+
+	resp.Body = &body{
+		ReadCloser: resp.Body,
+		span:       span,
+		json:       strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json"),
+	}
+
+	return resp, nil
+}
+
+func parsePath(path string) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var index string
+	if len(segments) > 0 && segments[0] != "" && !strings.HasPrefix(segments[0], "_") {
+		index = segments[0]
+		segments = segments[1:]
+	}
+
+	for _, segment := range segments {
+		if strings.HasPrefix(segment, "_") {
+			return index, segment
+		}
+	}
+
+	if index != "" {
+		return index, "document"
+	}
+
+	return index, "info"
+}
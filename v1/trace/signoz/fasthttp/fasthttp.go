@@ -0,0 +1,138 @@
+package signozfasthttp
+
+import (
+	"context"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const userValueKey = "signoz.context"
+
+type (
+	Option interface {
+		apply(config) config
+	}
+
+	config struct {
+		RouteFunc func(ctx *fasthttp.RequestCtx) string
+	}
+
+	option func(config) config
+
+	RequestHeaderCarrier struct {
+		Header *fasthttp.RequestHeader
+	}
+
+	ResponseHeaderCarrier struct {
+		Header *fasthttp.ResponseHeader
+	}
+)
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithRouteFunc(routeFunc func(ctx *fasthttp.RequestCtx) string) Option {
+	return option(func(config config) config {
+		config.RouteFunc = routeFunc
+		return config
+	})
+}
+
+func (c RequestHeaderCarrier) Get(key string) string {
+	return string(c.Header.Peek(key))
+}
+
+func (c RequestHeaderCarrier) Set(key, value string) {
+	c.Header.Set(key, value)
+}
+
+func (c RequestHeaderCarrier) Keys() []string {
+	var keys []string
+	c.Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+
+	return keys
+}
+
+func (c ResponseHeaderCarrier) Get(key string) string {
+	return string(c.Header.Peek(key))
+}
+
+func (c ResponseHeaderCarrier) Set(key, value string) {
+	c.Header.Set(key, value)
+}
+
+func (c ResponseHeaderCarrier) Keys() []string {
+	var keys []string
+	c.Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+
+	return keys
+}
+
+func Middleware(next fasthttp.RequestHandler, opts ...Option) fasthttp.RequestHandler {
+	config := config{RouteFunc: defaultRoute}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	return func(ctx *fasthttp.RequestCtx) {
+		start := time.Now()
+
+		method := string(ctx.Method())
+		parent := otel.GetTextMapPropagator().Extract(context.Background(), RequestHeaderCarrier{Header: &ctx.Request.Header})
+
+		spanCtx, span := signoz.Tracer().Start(
+			parent,
+			method+" "+string(ctx.Path()),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(method),
+				semconv.HTTPTargetKey.String(string(ctx.RequestURI())),
+				semconv.HTTPHostKey.String(string(ctx.Host())),
+				semconv.HTTPUserAgentKey.String(string(ctx.UserAgent())),
+				semconv.HTTPClientIPKey.String(ctx.RemoteIP().String()),
+			),
+		)
+		defer span.End()
+
+		ctx.SetUserValue(userValueKey, spanCtx)
+		signoz.CaptureBody(span, "Request", string(ctx.Request.Header.ContentType()), ctx.PostBody())
+		otel.GetTextMapPropagator().Inject(spanCtx, ResponseHeaderCarrier{Header: &ctx.Response.Header})
+		ctx.Response.Header.Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+		next(ctx)
+
+		if route := config.RouteFunc(ctx); route != "" {
+			span.SetName(method + " " + route)
+			span.SetAttributes(semconv.HTTPRouteKey.String(route))
+		}
+
+		signoz.CaptureBody(span, "Response", string(ctx.Response.Header.ContentType()), ctx.Response.Body())
+		signoz.EndHTTPServerSpan(span, ctx.Response.StatusCode(), len(ctx.Response.Body()), start)
+	}
+}
+
+func Context(ctx *fasthttp.RequestCtx) context.Context {
+	if spanCtx, ok := ctx.UserValue(userValueKey).(context.Context); ok {
+		return spanCtx
+	}
+
+	return context.Background()
+}
+
+func defaultRoute(ctx *fasthttp.RequestCtx) string {
+	if route, ok := ctx.UserValue("router.MatchedRoutePath").(string); ok {
+		return route
+	}
+
+	return ""
+}
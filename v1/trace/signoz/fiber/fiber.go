@@ -0,0 +1,55 @@
+package signozfiber
+
+import (
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	signozfasthttp "github.com/elraghifary/go-modules/v1/trace/signoz/fasthttp"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), signozfasthttp.RequestHeaderCarrier{Header: &c.Request().Header})
+		ctx, span := signoz.Tracer().Start(
+			ctx,
+			signoz.HTTPServerSpanName(c.Method(), ""),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(c.Method()),
+				semconv.HTTPTargetKey.String(string(c.Request().RequestURI())),
+				semconv.HTTPSchemeKey.String(c.Protocol()),
+				semconv.HTTPHostKey.String(c.Hostname()),
+				semconv.HTTPUserAgentKey.String(string(c.Request().Header.UserAgent())),
+				semconv.HTTPClientIPKey.String(c.IP()),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		signoz.CaptureBody(span, "Request", string(c.Request().Header.ContentType()), c.Body())
+
+		err := c.Next()
+		if err != nil {
+			span.RecordError(err)
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		if route := c.Route().Path; route != "" {
+			span.SetName(signoz.HTTPServerSpanName(c.Method(), route))
+			span.SetAttributes(semconv.HTTPRouteKey.String(route))
+		}
+
+		signoz.CaptureBody(span, "Response", string(c.Response().Header.ContentType()), c.Response().Body())
+		signoz.EndHTTPServerSpan(span, c.Response().StatusCode(), len(c.Response().Body()), start)
+
+		return nil
+	}
+}
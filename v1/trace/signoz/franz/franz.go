@@ -0,0 +1,158 @@
+package signozfranz
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	hooks struct{}
+
+	recordCarrier struct {
+		record *kgo.Record
+	}
+)
+
+var (
+	_ kgo.HookProduceRecordBuffered   = hooks{}
+	_ kgo.HookProduceRecordUnbuffered = hooks{}
+	_ kgo.HookFetchRecordBuffered     = hooks{}
+	_ kgo.HookFetchRecordUnbuffered   = hooks{}
+)
+
+func (c recordCarrier) Get(key string) string {
+	for _, header := range c.record.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c recordCarrier) Set(key, value string) {
+	for i, header := range c.record.Headers {
+		if header.Key == key {
+			c.record.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+
+	c.record.Headers = append(c.record.Headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+}
+
+func (c recordCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.record.Headers))
+	for _, header := range c.record.Headers {
+		keys = append(keys, header.Key)
+	}
+
+	return keys
+}
+
+func Hooks() kgo.Opt {
+	return kgo.WithHooks(hooks{})
+}
+
+func (hooks) OnProduceRecordBuffered(record *kgo.Record) {
+	ctx := record.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, _ = signoz.Tracer().Start(
+		ctx,
+		record.Topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(recordAttributes(record, "send")...),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, recordCarrier{record: record})
+	record.Context = ctx
+}
+
+func (hooks) OnProduceRecordUnbuffered(record *kgo.Record, err error) {
+	if record.Context == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(record.Context)
+	span.SetAttributes(
+		semconv.MessagingKafkaPartitionKey.Int(int(record.Partition)),
+		attribute.Int64("messaging.kafka.offset", record.Offset),
+	)
+	setError(span, err)
+	span.End()
+}
+
+func (hooks) OnFetchRecordBuffered(record *kgo.Record) {
+	ctx := record.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, _ = signoz.Tracer().Start(
+		otel.GetTextMapPropagator().Extract(ctx, recordCarrier{record: record}),
+		record.Topic+" receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(recordAttributes(record, "receive")...),
+	)
+	record.Context = ctx
+}
+
+func (hooks) OnFetchRecordUnbuffered(record *kgo.Record, _ bool) {
+	if record.Context == nil {
+		return
+	}
+
+	trace.SpanFromContext(record.Context).End()
+}
+
+func StartConsumerSpan(ctx context.Context, record *kgo.Record) (context.Context, trace.Span) {
+	parent := record.Context
+	if parent == nil {
+		parent = otel.GetTextMapPropagator().Extract(ctx, recordCarrier{record: record})
+	}
+
+	return signoz.Tracer().Start(
+		trace.ContextWithSpanContext(ctx, trace.SpanContextFromContext(parent)),
+		record.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(recordAttributes(record, "process")...),
+	)
+}
+
+func recordAttributes(record *kgo.Record, operation string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("kafka"),
+		semconv.MessagingDestinationKey.String(record.Topic),
+		semconv.MessagingDestinationKindTopic,
+		semconv.MessagingOperationKey.String(operation),
+	}
+	if operation != "send" {
+		attributes = append(attributes,
+			semconv.MessagingKafkaPartitionKey.Int(int(record.Partition)),
+			semconv.MessagingMessageIDKey.String(strconv.FormatInt(record.Offset, 10)),
+			attribute.Int64("messaging.kafka.offset", record.Offset),
+		)
+	}
+	if len(record.Key) > 0 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKeyKey.String(string(record.Key)))
+	}
+
+	return attributes
+}
+
+func setError(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
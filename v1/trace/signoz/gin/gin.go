@@ -0,0 +1,60 @@
+package signozgin
+
+import (
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/gin-gonic/gin"
+)
+
+type bodyWriter struct {
+	gin.ResponseWriter
+	body *signoz.BodyBuffer
+}
+
+func (w *bodyWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.body.Write(b[:n])
+
+	return n, err
+}
+
+func (w *bodyWriter) WriteString(s string) (int, error) {
+	n, err := w.ResponseWriter.WriteString(s)
+	w.body.Write([]byte(s[:n]))
+
+	return n, err
+}
+
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		ctx, span := signoz.StartHTTPServerSpan(c.Request, c.FullPath())
+		defer span.End()
+
+		var body *signoz.BodyBuffer
+		if signoz.BodyCaptureEnabled() {
+			body = signoz.NewBodyBuffer()
+			c.Writer = &bodyWriter{ResponseWriter: c.Writer, body: body}
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		for _, err := range c.Errors {
+			span.RecordError(err.Err)
+		}
+
+		signoz.CaptureResponseHeaders(span, c.Writer.Header())
+		if body != nil {
+			signoz.CaptureBody(span, "Response", c.Writer.Header().Get("Content-Type"), body.Bytes())
+		}
+
+		size := c.Writer.Size()
+		if size < 0 {
+			size = 0
+		}
+		signoz.EndHTTPServerSpan(span, c.Writer.Status(), size, start)
+	}
+}
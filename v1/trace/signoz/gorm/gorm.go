@@ -0,0 +1,141 @@
+package signozgorm
+
+import (
+	"errors"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type Option interface {
+	apply(plugin) plugin
+}
+
+type option func(plugin) plugin
+
+func (fn option) apply(p plugin) plugin {
+	return fn(p)
+}
+
+func WithDBName(dbName string) Option {
+	return option(func(p plugin) plugin {
+		p.dbName = dbName
+		return p
+	})
+}
+
+func WithStatement(sanitizer func(query string) string) Option {
+	return option(func(p plugin) plugin {
+		p.recordStatement = true
+		p.sanitizer = sanitizer
+		return p
+	})
+}
+
+type plugin struct {
+	dbSystem        signoz.DatabasePlatform
+	dbName          string
+	recordStatement bool
+	sanitizer       func(query string) string
+}
+
+func NewPlugin(dbSystem signoz.DatabasePlatform, opts ...Option) gorm.Plugin {
+	p := plugin{dbSystem: dbSystem}
+	for _, opt := range opts {
+		p = opt.apply(p)
+	}
+
+	return &p
+}
+
+func (p *plugin) Name() string {
+	return "signoz"
+}
+
+func (p *plugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+
+	registers := []func() error{
+		func() error {
+			return callback.Create().Before("gorm:create").Register("signoz:before_create", p.before("create"))
+		},
+		func() error { return callback.Create().After("gorm:create").Register("signoz:after_create", p.after) },
+		func() error {
+			return callback.Query().Before("gorm:query").Register("signoz:before_query", p.before("query"))
+		},
+		func() error { return callback.Query().After("gorm:query").Register("signoz:after_query", p.after) },
+		func() error {
+			return callback.Update().Before("gorm:update").Register("signoz:before_update", p.before("update"))
+		},
+		func() error { return callback.Update().After("gorm:update").Register("signoz:after_update", p.after) },
+		func() error {
+			return callback.Delete().Before("gorm:delete").Register("signoz:before_delete", p.before("delete"))
+		},
+		func() error { return callback.Delete().After("gorm:delete").Register("signoz:after_delete", p.after) },
+		func() error { return callback.Row().Before("gorm:row").Register("signoz:before_row", p.before("row")) },
+		func() error { return callback.Row().After("gorm:row").Register("signoz:after_row", p.after) },
+		func() error { return callback.Raw().Before("gorm:raw").Register("signoz:before_raw", p.before("raw")) },
+		func() error { return callback.Raw().After("gorm:raw").Register("signoz:after_raw", p.after) },
+	}
+
+	for _, register := range registers {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *plugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		attributes := []attribute.KeyValue{
+			semconv.DBSystemKey.String(string(p.dbSystem)),
+			semconv.DBOperationKey.String(operation),
+		}
+		if p.dbName != "" {
+			attributes = append(attributes, semconv.DBNameKey.String(p.dbName))
+		}
+
+		ctx, _ := signoz.Tracer().Start(
+			db.Statement.Context,
+			"gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attributes...),
+		)
+		db.Statement.Context = ctx
+	}
+}
+
+func (p *plugin) after(db *gorm.DB) {
+	span := trace.SpanFromContext(db.Statement.Context)
+	if !span.IsRecording() {
+		return
+	}
+	defer span.End()
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBSQLTableKey.String(db.Statement.Table))
+	}
+	span.SetAttributes(attribute.Int64("db.rows_affected", db.Statement.RowsAffected))
+	if operation := signoz.SQLOperation(db.Statement.SQL.String()); operation != "" {
+		span.SetAttributes(semconv.DBOperationKey.String(operation))
+	}
+
+	if p.recordStatement {
+		statement := signoz.SanitizeSQL(db.Statement.SQL.String())
+		if p.sanitizer != nil {
+			statement = p.sanitizer(db.Statement.SQL.String())
+		}
+		span.SetAttributes(semconv.DBStatementKey.String(statement))
+	}
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, db.Error.Error())
+		span.RecordError(db.Error)
+	}
+}
@@ -0,0 +1,70 @@
+package signozgqlgen
+
+import "strings"
+
+func sanitizeDocument(document string) string {
+	var builder strings.Builder
+	builder.Grow(len(document))
+
+	for i := 0; i < len(document); {
+		c := document[i]
+
+		switch {
+		case strings.HasPrefix(document[i:], `"""`):
+			end := strings.Index(document[i+3:], `"""`)
+			if end < 0 {
+				i = len(document)
+			} else {
+				i += end + 6
+			}
+			builder.WriteString(`"?"`)
+		case c == '"':
+			i = skipString(document, i)
+			builder.WriteString(`"?"`)
+		case c == '#':
+			end := strings.IndexByte(document[i:], '\n')
+			if end < 0 {
+				i = len(document)
+				continue
+			}
+			i += end
+		case isDigit(c) || (c == '-' && i+1 < len(document) && isDigit(document[i+1])):
+			for i++; i < len(document) && (isDigit(document[i]) || strings.IndexByte(".eE+-", document[i]) >= 0); i++ {
+			}
+			builder.WriteByte('0')
+		case isNameStart(c) || c == '$':
+			end := i + 1
+			for end < len(document) && (isNameStart(document[end]) || isDigit(document[end])) {
+				end++
+			}
+			builder.WriteString(document[i:end])
+			i = end
+		default:
+			builder.WriteByte(c)
+			i++
+		}
+	}
+
+	return builder.String()
+}
+
+func skipString(document string, i int) int {
+	for i++; i < len(document); i++ {
+		switch document[i] {
+		case '\\':
+			i++
+		case '"', '\n':
+			return i + 1
+		}
+	}
+
+	return len(document)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,30 @@
+package signozgqlgen
+
+import "testing"
+
+func TestSanitizeDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     string
+	}{
+		{"no literals", "query { me { id name } }", "query { me { id name } }"},
+		{"string argument", `query { user(email: "a@b.com") { id } }`, `query { user(email: "?") { id } }`},
+		{"escaped quote", `query { user(name: "say \"hi\"") { id } }`, `query { user(name: "?") { id } }`},
+		{"block string", `mutation { note(text: """multi
+line""") }`, `mutation { note(text: "?") }`},
+		{"numbers", "query { items(first: 10, min: -1.5e3) { id } }", "query { items(first: 0, min: 0) { id } }"},
+		{"comment", "query { # secret\n me { id } }", "query { \n me { id } }"},
+		{"variables kept", "query ($id: ID!) { user(id: $id) { id } }", "query ($id: ID!) { user(id: $id) { id } }"},
+		{"names with digits", "query { field2 { sha256 } }", "query { field2 { sha256 } }"},
+		{"unterminated string", `query { user(name: "open`, `query { user(name: "?"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeDocument(tt.document); got != tt.want {
+				t.Errorf("sanitizeDocument(%q) = %q, want %q", tt.document, got, tt.want)
+			}
+		})
+	}
+}
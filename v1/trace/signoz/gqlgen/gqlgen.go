@@ -0,0 +1,114 @@
+package signozgqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Tracer struct {
+	RawDocument bool
+}
+
+var (
+	_ graphql.HandlerExtension    = Tracer{}
+	_ graphql.ResponseInterceptor = Tracer{}
+	_ graphql.FieldInterceptor    = Tracer{}
+)
+
+func (Tracer) ExtensionName() string {
+	return "SignozTracer"
+}
+
+func (Tracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+
+	name := oc.OperationName
+	if name == "" {
+		name = "anonymous"
+	}
+
+	document := oc.RawQuery
+	if !t.RawDocument {
+		document = sanitizeDocument(document)
+	}
+
+	attributes := []attribute.KeyValue{
+		attribute.String("graphql.operation.name", name),
+		attribute.String("graphql.document", document),
+	}
+	if oc.Operation != nil {
+		attributes = append(attributes, attribute.String("graphql.operation.type", string(oc.Operation.Operation)))
+	}
+	if stats := extension.GetComplexityStats(ctx); stats != nil {
+		attributes = append(attributes,
+			attribute.Int("graphql.complexity", stats.Complexity),
+			attribute.Int("graphql.complexity_limit", stats.ComplexityLimit),
+		)
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		"graphql "+name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetAttributes(attribute.Int("graphql.errors.count", len(resp.Errors)))
+		span.SetStatus(codes.Error, resp.Errors.Error())
+		for _, err := range resp.Errors {
+			span.RecordError(err)
+		}
+	}
+
+	return resp
+}
+
+func (Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		fc.Object+"."+fc.Field.Name,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("graphql.resolver.object", fc.Object),
+			attribute.String("graphql.resolver.field", fc.Field.Name),
+			attribute.String("graphql.resolver.path", fc.Path().String()),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+
+	if errs := graphql.GetFieldErrors(ctx, fc); len(errs) > 0 {
+		span.SetAttributes(attribute.Int("graphql.errors.count", len(errs)))
+		span.SetStatus(codes.Error, errs.Error())
+		for _, fieldErr := range errs {
+			span.RecordError(fieldErr)
+		}
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return res, err
+}
@@ -0,0 +1,127 @@
+package signozgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type clientStream struct {
+	grpc.ClientStream
+	desc *grpc.StreamDesc
+	span trace.Span
+	once sync.Once
+	done chan struct{}
+}
+
+func newClientStream(ctx context.Context, cs grpc.ClientStream, desc *grpc.StreamDesc, span trace.Span) *clientStream {
+	s := &clientStream{ClientStream: cs, desc: desc, span: span, done: make(chan struct{})}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.end(status.FromContextError(ctx.Err()).Err())
+		case <-s.done:
+		}
+	}()
+
+	return s
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		s.end(nil)
+	} else if err != nil {
+		s.end(err)
+	} else if !s.desc.ServerStreams {
+		s.end(nil)
+	}
+
+	return err
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil {
+		s.end(err)
+	}
+
+	return md, err
+}
+
+func (s *clientStream) end(err error) {
+	s.once.Do(func() {
+		endClientSpan(s.span, err)
+		s.span.End()
+		close(s.done)
+	})
+}
+
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, method, cc.Target())
+		defer span.End()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endClientSpan(span, err)
+
+		return err
+	}
+}
+
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method, cc.Target())
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endClientSpan(span, err)
+			span.End()
+			return cs, err
+		}
+
+		return newClientStream(ctx, cs, desc, span), nil
+	}
+}
+
+func startClientSpan(ctx context.Context, fullMethod string, target string) (context.Context, trace.Span) {
+	name, attributes := spanInfo(fullMethod, targetAddress(target))
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	} else {
+		md = md.Copy()
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier{md: &md})
+
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func endClientSpan(span trace.Span, err error) {
+	s, _ := status.FromError(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+
+	if err != nil {
+		span.SetStatus(otelcodes.Error, s.Message())
+		span.RecordError(err)
+	}
+}
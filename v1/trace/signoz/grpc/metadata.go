@@ -0,0 +1,82 @@
+package signozgrpc
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"google.golang.org/grpc/metadata"
+)
+
+type metadataCarrier struct {
+	md *metadata.MD
+}
+
+func (c metadataCarrier) Get(key string) string {
+	values := c.md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	c.md.Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.md))
+	for key := range *c.md {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func spanInfo(fullMethod string, peerAddress string) (string, []attribute.KeyValue) {
+	name := strings.TrimPrefix(fullMethod, "/")
+
+	attributes := []attribute.KeyValue{semconv.RPCSystemKey.String("grpc")}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		attributes = append(attributes,
+			semconv.RPCServiceKey.String(name[:i]),
+			semconv.RPCMethodKey.String(name[i+1:]),
+		)
+	}
+
+	return name, append(attributes, peerAttributes(peerAddress)...)
+}
+
+func targetAddress(target string) string {
+	if i := strings.Index(target, "://"); i >= 0 {
+		target = target[i+3:]
+		if j := strings.IndexByte(target, '/'); j >= 0 {
+			target = target[j+1:]
+		}
+	}
+
+	return target
+}
+
+func peerAttributes(address string) []attribute.KeyValue {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		if address == "" || strings.ContainsAny(address, "/:") {
+			return nil
+		}
+		host, port = address, ""
+	}
+
+	attributes := []attribute.KeyValue{semconv.NetPeerNameKey.String(host)}
+	if net.ParseIP(host) != nil {
+		attributes[0] = semconv.NetPeerIPKey.String(host)
+	}
+	if p, err := strconv.Atoi(port); err == nil {
+		attributes = append(attributes, semconv.NetPeerPortKey.Int(p))
+	}
+
+	return attributes
+}
@@ -0,0 +1,42 @@
+package signozgrpcgateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func Handler(mux *runtime.ServeMux) http.Handler {
+	return signoz.HTTPMiddleware(mux)
+}
+
+func ServeMuxOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithMetadata(Annotator),
+	}
+}
+
+func Annotator(ctx context.Context, r *http.Request) metadata.MD {
+	span := trace.SpanFromContext(ctx)
+	if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+		span.SetName(r.Method + " " + pattern)
+		span.SetAttributes(semconv.HTTPRouteKey.String(pattern))
+	}
+
+	carrier := propagation.HeaderCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	md := metadata.MD{}
+	for key := range carrier {
+		md.Set(key, carrier.Get(key))
+	}
+
+	return md
+}
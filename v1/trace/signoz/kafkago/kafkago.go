@@ -0,0 +1,186 @@
+package signozkafkago
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	Writer struct {
+		*kafka.Writer
+	}
+
+	Reader struct {
+		*kafka.Reader
+	}
+
+	headerCarrier struct {
+		msg *kafka.Message
+	}
+)
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range c.msg.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range c.msg.Headers {
+		if header.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+
+	c.msg.Headers = append(c.msg.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, header := range c.msg.Headers {
+		keys = append(keys, header.Key)
+	}
+
+	return keys
+}
+
+func Inject(ctx context.Context, msg *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{msg: msg})
+}
+
+func Extract(ctx context.Context, msg *kafka.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, headerCarrier{msg: msg})
+}
+
+func WrapWriter(w *kafka.Writer) *Writer {
+	return &Writer{Writer: w}
+}
+
+func WrapReader(r *kafka.Reader) *Reader {
+	return &Reader{Reader: r}
+}
+
+func (w *Writer) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	spans := make([]trace.Span, len(msgs))
+	for i := range msgs {
+		topic := msgs[i].Topic
+		if topic == "" {
+			topic = w.Topic
+		}
+
+		var spanCtx context.Context
+		spanCtx, spans[i] = signoz.Tracer().Start(
+			ctx,
+			topic+" send",
+			trace.WithSpanKind(trace.SpanKindProducer),
+			trace.WithAttributes(messageAttributes(topic, &msgs[i], "send")...),
+		)
+		Inject(spanCtx, &msgs[i])
+	}
+
+	err := w.Writer.WriteMessages(ctx, msgs...)
+	for i, span := range spans {
+		setError(span, messageError(err, i))
+		span.End()
+	}
+
+	return err
+}
+
+func (r *Reader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	msg, err := r.Reader.FetchMessage(ctx)
+	if err != nil {
+		return msg, err
+	}
+	r.receive(ctx, &msg)
+
+	return msg, nil
+}
+
+func (r *Reader) ReadMessage(ctx context.Context) (kafka.Message, error) {
+	msg, err := r.Reader.ReadMessage(ctx)
+	if err != nil {
+		return msg, err
+	}
+	r.receive(ctx, &msg)
+
+	return msg, nil
+}
+
+func (r *Reader) receive(ctx context.Context, msg *kafka.Message) {
+	attributes := messageAttributes(msg.Topic, msg, "receive")
+	if groupID := r.Config().GroupID; groupID != "" {
+		attributes = append(attributes, semconv.MessagingKafkaConsumerGroupKey.String(groupID))
+	}
+
+	spanCtx, span := signoz.Tracer().Start(
+		Extract(ctx, msg),
+		msg.Topic+" receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attributes...),
+	)
+	span.End()
+
+	Inject(spanCtx, msg)
+}
+
+func StartConsumerSpan(ctx context.Context, msg *kafka.Message) (context.Context, trace.Span) {
+	return signoz.Tracer().Start(
+		Extract(ctx, msg),
+		msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(messageAttributes(msg.Topic, msg, "process")...),
+	)
+}
+
+func messageAttributes(topic string, msg *kafka.Message, operation string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("kafka"),
+		semconv.MessagingDestinationKey.String(topic),
+		semconv.MessagingDestinationKindTopic,
+		semconv.MessagingOperationKey.String(operation),
+	}
+	if operation != "send" {
+		attributes = append(attributes,
+			semconv.MessagingKafkaPartitionKey.Int(msg.Partition),
+			semconv.MessagingMessageIDKey.String(strconv.FormatInt(msg.Offset, 10)),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+		)
+	}
+	if len(msg.Key) > 0 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKeyKey.String(string(msg.Key)))
+	}
+
+	return attributes
+}
+
+func messageError(err error, i int) error {
+	if writeErrors, ok := err.(kafka.WriteErrors); ok {
+		if i < len(writeErrors) {
+			return writeErrors[i]
+		}
+		return nil
+	}
+
+	return err
+}
+
+func setError(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
@@ -0,0 +1,90 @@
+package signozmongo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type monitor struct {
+	mu    sync.Mutex
+	spans map[int64]trace.Span
+}
+
+func NewMonitor() *event.CommandMonitor {
+	m := &monitor{spans: map[int64]trace.Span{}}
+
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *monitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String("mongodb"),
+		semconv.DBNameKey.String(evt.DatabaseName),
+		semconv.DBOperationKey.String(evt.CommandName),
+		attribute.String("db.mongodb.connection_id", evt.ConnectionID),
+	}
+
+	name := evt.CommandName
+	if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+		name = collection + "." + evt.CommandName
+		attributes = append(attributes, semconv.DBMongoDBCollectionKey.String(collection))
+	}
+
+	_, span := signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	m.mu.Lock()
+	m.spans[evt.RequestID] = span
+	m.mu.Unlock()
+}
+
+func (m *monitor) succeeded(_ context.Context, evt *event.CommandSucceededEvent) {
+	span, ok := m.span(evt.RequestID)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.duration_ms", evt.Duration.Milliseconds()))
+	span.End()
+}
+
+func (m *monitor) failed(_ context.Context, evt *event.CommandFailedEvent) {
+	span, ok := m.span(evt.RequestID)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.duration_ms", evt.Duration.Milliseconds()))
+	span.SetStatus(codes.Error, evt.Failure)
+	span.AddEvent("exception", trace.WithAttributes(
+		attribute.String("exception.message", evt.Failure),
+	))
+	span.End()
+}
+
+func (m *monitor) span(requestID int64) (trace.Span, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	span, ok := m.spans[requestID]
+	if ok {
+		delete(m.spans, requestID)
+	}
+
+	return span, ok
+}
@@ -0,0 +1,32 @@
+package signozmux
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/gorilla/mux"
+)
+
+func Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			var route string
+			if currentRoute := mux.CurrentRoute(r); currentRoute != nil {
+				if pathTemplate, err := currentRoute.GetPathTemplate(); err == nil {
+					route = pathTemplate
+				}
+			}
+
+			ctx, span := signoz.StartHTTPServerSpan(r, route)
+			defer span.End()
+
+			rw := signoz.NewResponseWriter(w)
+			next.ServeHTTP(rw, r.WithContext(ctx))
+
+			rw.End(span, start)
+		})
+	}
+}
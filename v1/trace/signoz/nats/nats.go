@@ -0,0 +1,161 @@
+package signoznats
+
+import (
+	"context"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	MsgHandler func(ctx context.Context, msg *nats.Msg)
+
+	headerCarrier struct {
+		msg *nats.Msg
+	}
+)
+
+func (c headerCarrier) Get(key string) string {
+	if c.msg.Header == nil {
+		return ""
+	}
+
+	return c.msg.Header.Get(key)
+}
+
+func (c headerCarrier) Set(key, value string) {
+	if c.msg.Header == nil {
+		c.msg.Header = nats.Header{}
+	}
+
+	c.msg.Header.Set(key, value)
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Header))
+	for key := range c.msg.Header {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func Publish(ctx context.Context, nc *nats.Conn, subject string, data []byte) error {
+	return PublishMsg(ctx, nc, &nats.Msg{Subject: subject, Data: data})
+}
+
+func PublishMsg(ctx context.Context, nc *nats.Conn, msg *nats.Msg) error {
+	ctx, span := startProducerSpan(ctx, msg, false)
+	defer span.End()
+
+	err := nc.PublishMsg(msg)
+	setError(span, err)
+
+	return err
+}
+
+func PublishJetStream(ctx context.Context, js nats.JetStreamContext, msg *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	ctx, span := startProducerSpan(ctx, msg, true)
+	defer span.End()
+
+	ack, err := js.PublishMsg(msg, append(opts, nats.Context(ctx))...)
+	if err != nil {
+		setError(span, err)
+		return ack, err
+	}
+
+	span.SetAttributes(
+		attribute.String("messaging.nats.stream", ack.Stream),
+		attribute.Int64("messaging.nats.sequence", int64(ack.Sequence)),
+		attribute.Bool("messaging.nats.duplicate", ack.Duplicate),
+	)
+
+	return ack, nil
+}
+
+func WrapHandler(handler MsgHandler) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		attributes := []attribute.KeyValue{
+			semconv.MessagingSystemKey.String("nats"),
+			semconv.MessagingDestinationKey.String(msg.Subject),
+			semconv.MessagingOperationKey.String("process"),
+			semconv.MessagingMessagePayloadSizeBytesKey.Int(len(msg.Data)),
+		}
+		if msg.Sub != nil && msg.Sub.Queue != "" {
+			attributes = append(attributes, attribute.String("messaging.nats.queue", msg.Sub.Queue))
+		}
+		if metadata, err := msg.Metadata(); err == nil {
+			attributes = append(attributes,
+				attribute.String("messaging.nats.stream", metadata.Stream),
+				attribute.String("messaging.nats.consumer", metadata.Consumer),
+				attribute.Int64("messaging.nats.sequence", int64(metadata.Sequence.Stream)),
+				attribute.Int64("messaging.nats.num_delivered", int64(metadata.NumDelivered)),
+			)
+		}
+
+		ctx, span := signoz.Tracer().Start(
+			otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier{msg: msg}),
+			msg.Subject+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(attributes...),
+		)
+		defer span.End()
+
+		handler(ctx, msg)
+	}
+}
+
+func Ack(ctx context.Context, msg *nats.Msg) error {
+	return outcome(ctx, "ack", msg.Ack())
+}
+
+func Nak(ctx context.Context, msg *nats.Msg) error {
+	return outcome(ctx, "nak", msg.Nak())
+}
+
+func Term(ctx context.Context, msg *nats.Msg) error {
+	return outcome(ctx, "term", msg.Term())
+}
+
+func InProgress(ctx context.Context, msg *nats.Msg) error {
+	return outcome(ctx, "in_progress", msg.InProgress())
+}
+
+func outcome(ctx context.Context, result string, err error) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("messaging.nats.ack", result))
+	span.AddEvent(result)
+	setError(span, err)
+
+	return err
+}
+
+func startProducerSpan(ctx context.Context, msg *nats.Msg, jetStream bool) (context.Context, trace.Span) {
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		msg.Subject+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("nats"),
+			semconv.MessagingDestinationKey.String(msg.Subject),
+			semconv.MessagingOperationKey.String("send"),
+			semconv.MessagingMessagePayloadSizeBytesKey.Int(len(msg.Data)),
+			attribute.Bool("messaging.nats.jetstream", jetStream),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{msg: msg})
+
+	return ctx, span
+}
+
+func setError(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
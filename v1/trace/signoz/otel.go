@@ -0,0 +1,106 @@
+package signoz
+
+import (
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+)
+
+type (
+	SpanType         = otel.SpanType
+	DatabasePlatform = otel.DatabasePlatform
+	ExternalURL      = otel.ExternalURL
+	SpanTypeOption   = otel.SpanTypeOption
+	BodyBuffer       = otel.BodyBuffer
+	GoOption         = otel.GoOption
+	TracedGroup      = otel.TracedGroup
+	PoolOption       = otel.PoolOption
+	WorkerPool       = otel.WorkerPool
+	Masker           = otel.Masker
+	FileOutput       = otel.FileOutput
+	Stats            = otel.Stats
+	EventSchema      = otel.EventSchema
+	ValueType        = otel.ValueType
+	PayloadStore     = otel.PayloadStore
+	AuditEvent       = otel.AuditEvent
+	AuditHook        = otel.AuditHook
+	URLPathRule      = otel.URLPathRule
+	BreakerState     = otel.BreakerState
+	ResponseWriter   = otel.ResponseWriter
+)
+
+const (
+	Unspecified = otel.Unspecified
+	Internal    = otel.Internal
+	Server      = otel.Server
+	Client      = otel.Client
+	Producer    = otel.Producer
+	Consumer    = otel.Consumer
+
+	Other      = otel.Other
+	MySQL      = otel.MySQL
+	MariaDB    = otel.MariaDB
+	Redis      = otel.Redis
+	PostgreSQL = otel.PostgreSQL
+
+	StringValue = otel.StringValue
+	IntValue    = otel.IntValue
+	FloatValue  = otel.FloatValue
+	BoolValue   = otel.BoolValue
+
+	BreakerClosed   = otel.BreakerClosed
+	BreakerOpen     = otel.BreakerOpen
+	BreakerHalfOpen = otel.BreakerHalfOpen
+)
+
+var (
+	ErrPoolClosed = otel.ErrPoolClosed
+
+	Tracer                      = otel.Tracer
+	SetSpanAttributes           = otel.SetSpanAttributes
+	AddSpanEvent                = otel.AddSpanEvent
+	DatabaseCalls               = otel.DatabaseCalls
+	ExternalCalls               = otel.ExternalCalls
+	WithStartTimestamp          = otel.WithStartTimestamp
+	SanitizeSQL                 = otel.SanitizeSQL
+	SQLOperation                = otel.SQLOperation
+	SanitizeURL                 = otel.SanitizeURL
+	RegisterRoutes              = otel.RegisterRoutes
+	Route                       = otel.Route
+	RecordCancellation          = otel.RecordCancellation
+	CancellationMiddleware      = otel.CancellationMiddleware
+	TraceRetry                  = otel.TraceRetry
+	RecordBreakerState          = otel.RecordBreakerState
+	SetBreakerState             = otel.SetBreakerState
+	RecordBreakerRejection      = otel.RecordBreakerRejection
+	LinkFromContext             = otel.LinkFromContext
+	ContextWithLink             = otel.ContextWithLink
+	StartLinkedSpan             = otel.StartLinkedSpan
+	Go                          = otel.Go
+	WithChildSpan               = otel.WithChildSpan
+	WithGoSpanName              = otel.WithGoSpanName
+	RecoverSpan                 = otel.RecoverSpan
+	Group                       = otel.Group
+	NewWorkerPool               = otel.NewWorkerPool
+	WithPoolName                = otel.WithPoolName
+	WithQueueSize               = otel.WithQueueSize
+	WithLinkedJobs              = otel.WithLinkedJobs
+	HTTPMiddleware              = otel.HTTPMiddleware
+	StartHTTPServerSpan         = otel.StartHTTPServerSpan
+	HTTPServerSpanName          = otel.HTTPServerSpanName
+	NewResponseWriter           = otel.NewResponseWriter
+	EndHTTPServerSpan           = otel.EndHTTPServerSpan
+	CaptureRequestHeaders       = otel.CaptureRequestHeaders
+	CaptureResponseHeaders      = otel.CaptureResponseHeaders
+	NewBodyBuffer               = otel.NewBodyBuffer
+	BodyCaptureEnabled          = otel.BodyCaptureEnabled
+	CaptureRequestBody          = otel.CaptureRequestBody
+	CaptureBody                 = otel.CaptureBody
+	WrapTransport               = otel.WrapTransport
+	AddMasker                   = otel.AddMasker
+	Mask                        = otel.Mask
+	NewDeterministicIDGenerator = otel.NewDeterministicIDGenerator
+	Enabled                     = otel.Enabled
+	GetStats                    = otel.GetStats
+	RegisterEventSchema         = otel.RegisterEventSchema
+	AddAuditEvent               = otel.AddAuditEvent
+	SetEnabled                  = otel.SetEnabled
+)
@@ -0,0 +1,151 @@
+package signozpgx
+
+import (
+	"context"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Option interface {
+	apply(Tracer) Tracer
+}
+
+type option func(Tracer) Tracer
+
+func (fn option) apply(t Tracer) Tracer {
+	return fn(t)
+}
+
+func WithStatement(sanitizer func(query string) string) Option {
+	return option(func(t Tracer) Tracer {
+		t.recordStatement = true
+		t.sanitizer = sanitizer
+		return t
+	})
+}
+
+type Tracer struct {
+	recordStatement bool
+	sanitizer       func(query string) string
+}
+
+func NewTracer(opts ...Option) *Tracer {
+	t := Tracer{}
+	for _, opt := range opts {
+		t = opt.apply(t)
+	}
+
+	return &t
+}
+
+func (t *Tracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = t.startSpan(ctx, conn, "query", data.SQL)
+	return ctx
+}
+
+func (t *Tracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	endSpan(span, data.Err)
+}
+
+func (t *Tracer) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	ctx, span := t.startSpan(ctx, conn, "batch", "")
+	if data.Batch != nil {
+		span.SetAttributes(attribute.Int("db.batch.size", data.Batch.Len()))
+	}
+
+	return ctx
+}
+
+func (t *Tracer) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	attributes := []attribute.KeyValue{
+		semconv.DBOperationKey.String(signoz.SQLOperation(data.SQL)),
+	}
+	if t.recordStatement {
+		attributes = append(attributes, semconv.DBStatementKey.String(t.statement(data.SQL)))
+	}
+	if data.Err != nil {
+		attributes = append(attributes, attribute.String("error", data.Err.Error()))
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("batch.query", trace.WithAttributes(attributes...))
+}
+
+func (t *Tracer) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	endSpan(trace.SpanFromContext(ctx), data.Err)
+}
+
+func (t *Tracer) TraceConnectStart(ctx context.Context, data pgx.TraceConnectStartData) context.Context {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String(string(signoz.PostgreSQL)),
+	}
+	if data.ConnConfig != nil {
+		attributes = append(attributes,
+			semconv.DBNameKey.String(data.ConnConfig.Database),
+			semconv.NetPeerNameKey.String(data.ConnConfig.Host),
+			semconv.NetPeerPortKey.Int(int(data.ConnConfig.Port)),
+		)
+	}
+
+	ctx, _ = signoz.Tracer().Start(
+		ctx,
+		"connect",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx
+}
+
+func (t *Tracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	endSpan(trace.SpanFromContext(ctx), data.Err)
+}
+
+func (t *Tracer) startSpan(ctx context.Context, conn *pgx.Conn, name, query string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String(string(signoz.PostgreSQL)),
+	}
+	if conn != nil && conn.Config() != nil {
+		attributes = append(attributes, semconv.DBNameKey.String(conn.Config().Database))
+	}
+	if query != "" {
+		if operation := signoz.SQLOperation(query); operation != "" {
+			name = operation
+			attributes = append(attributes, semconv.DBOperationKey.String(operation))
+		}
+		if t.recordStatement {
+			attributes = append(attributes, semconv.DBStatementKey.String(t.statement(query)))
+		}
+	}
+
+	return signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func (t *Tracer) statement(query string) string {
+	if t.sanitizer != nil {
+		return t.sanitizer(query)
+	}
+
+	return signoz.SanitizeSQL(query)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+	span.End()
+}
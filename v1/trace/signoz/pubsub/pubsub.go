@@ -0,0 +1,84 @@
+package signozpubsub
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReceiveHandler func(ctx context.Context, msg *pubsub.Message)
+
+func Publish(ctx context.Context, topic *pubsub.Topic, msg *pubsub.Message) *pubsub.PublishResult {
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		topic.ID()+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("gcp_pubsub"),
+			semconv.MessagingDestinationKey.String(topic.String()),
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingOperationKey.String("send"),
+			semconv.MessagingMessagePayloadSizeBytesKey.Int(len(msg.Data)),
+		),
+	)
+
+	if msg.Attributes == nil {
+		msg.Attributes = map[string]string{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(msg.Attributes))
+
+	result := topic.Publish(ctx, msg)
+	go func() {
+		defer span.End()
+
+		serverID, err := result.Get(context.Background())
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+			return
+		}
+		span.SetAttributes(semconv.MessagingMessageIDKey.String(serverID))
+	}()
+
+	return result
+}
+
+func Receive(ctx context.Context, sub *pubsub.Subscription, handler ReceiveHandler) error {
+	return sub.Receive(ctx, WrapHandler(sub, handler))
+}
+
+func WrapHandler(sub *pubsub.Subscription, handler ReceiveHandler) func(context.Context, *pubsub.Message) {
+	return func(ctx context.Context, msg *pubsub.Message) {
+		if msg.Attributes != nil {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.Attributes))
+		}
+
+		attributes := []attribute.KeyValue{
+			semconv.MessagingSystemKey.String("gcp_pubsub"),
+			semconv.MessagingOperationKey.String("process"),
+			semconv.MessagingMessageIDKey.String(msg.ID),
+			semconv.MessagingMessagePayloadSizeBytesKey.Int(len(msg.Data)),
+			attribute.String("messaging.gcp_pubsub.subscription", sub.String()),
+		}
+		if msg.DeliveryAttempt != nil {
+			attributes = append(attributes, attribute.Int("messaging.gcp_pubsub.delivery_attempt", *msg.DeliveryAttempt))
+		}
+
+		ctx, span := signoz.Tracer().Start(
+			ctx,
+			sub.ID()+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(attributes...),
+		)
+		defer span.End()
+
+		handler(ctx, msg)
+	}
+}
@@ -0,0 +1,103 @@
+package signozredis
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type hook struct{}
+
+func NewHook() redis.Hook {
+	return hook{}
+}
+
+func (hook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		ctx, span := startSpan(ctx, "redis.dial")
+		defer span.End()
+
+		conn, err := next(ctx, network, addr)
+		setError(span, err)
+
+		return conn, err
+	}
+}
+
+func (hook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := startSpan(ctx, cmd.FullName(),
+			semconv.DBOperationKey.String(cmd.Name()),
+			attribute.Int("db.redis.key_count", keyCount(cmd)),
+		)
+		defer span.End()
+
+		err := next(ctx, cmd)
+		setError(span, err)
+
+		return err
+	}
+}
+
+func (hook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		names := make([]string, 0, len(cmds))
+		for _, cmd := range cmds {
+			names = append(names, cmd.Name())
+		}
+
+		ctx, span := startSpan(ctx, "redis.pipeline",
+			semconv.DBOperationKey.String(strings.Join(names, " ")),
+			attribute.Int("db.redis.num_cmd", len(cmds)),
+		)
+		defer span.End()
+
+		err := next(ctx, cmds)
+		setError(span, err)
+
+		return err
+	}
+}
+
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemKey.String(string(signoz.Redis))),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func setError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+}
+
+func keyCount(cmd redis.Cmder) int {
+	args := len(cmd.Args()) - 1
+	if args <= 0 {
+		return 0
+	}
+
+	switch cmd.Name() {
+	case "mget", "del", "exists", "unlink", "touch", "watch":
+		return args
+	case "mset", "msetnx":
+		return args / 2
+	}
+
+	return 1
+}
@@ -0,0 +1,108 @@
+package signozresty
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type parentContextKey struct{}
+
+func Instrument(client *resty.Client) *resty.Client {
+	return client.
+		OnBeforeRequest(beforeRequest).
+		OnAfterResponse(afterResponse).
+		OnError(onError).
+		AddRetryHook(onRetry)
+}
+
+func beforeRequest(_ *resty.Client, req *resty.Request) error {
+	parent, ok := req.Context().Value(parentContextKey{}).(context.Context)
+	if !ok {
+		parent = req.Context()
+	} else if previous := trace.SpanFromContext(req.Context()); previous.IsRecording() {
+		previous.End()
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		context.WithValue(parent, parentContextKey{}, parent),
+		"HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPURLKey.String(signoz.SanitizeURL(req.URL)),
+			attribute.Int("http.resend_count", req.Attempt),
+		),
+	)
+	req.SetContext(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	if req.Attempt > 1 {
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("http.retry.attempt", req.Attempt),
+		))
+	}
+
+	return nil
+}
+
+func afterResponse(_ *resty.Client, resp *resty.Response) error {
+	span := trace.SpanFromContext(resp.Request.Context())
+	span.SetAttributes(
+		semconv.HTTPStatusCodeKey.Int(resp.StatusCode()),
+		semconv.HTTPResponseContentLengthKey.Int64(resp.Size()),
+	)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode(), trace.SpanKindClient))
+	span.End()
+
+	return nil
+}
+
+func onError(req *resty.Request, err error) {
+	span := trace.SpanFromContext(req.Context())
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+	span.End()
+}
+
+func onRetry(resp *resty.Response, err error) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+
+	parent, ok := resp.Request.Context().Value(parentContextKey{}).(context.Context)
+	if !ok {
+		return
+	}
+
+	if span := trace.SpanFromContext(resp.Request.Context()); span.IsRecording() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+		span.End()
+	}
+
+	attributes := []attribute.KeyValue{
+		attribute.Int("http.retry.attempt", resp.Request.Attempt),
+	}
+	if err != nil {
+		attributes = append(attributes, attribute.String("http.retry.reason", err.Error()))
+	} else {
+		attributes = append(attributes, attribute.String("http.retry.reason", "status "+strconv.Itoa(resp.StatusCode())))
+	}
+
+	trace.SpanFromContext(parent).AddEvent("retry", trace.WithAttributes(attributes...))
+}
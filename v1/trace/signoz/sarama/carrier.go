@@ -0,0 +1,86 @@
+package signozsarama
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+)
+
+type producerMessageCarrier struct {
+	msg *sarama.ProducerMessage
+}
+
+func (c producerMessageCarrier) Get(key string) string {
+	for _, header := range c.msg.Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c producerMessageCarrier) Set(key, value string) {
+	for i, header := range c.msg.Headers {
+		if string(header.Key) == key {
+			c.msg.Headers = append(c.msg.Headers[:i], c.msg.Headers[i+1:]...)
+			break
+		}
+	}
+
+	c.msg.Headers = append(c.msg.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c producerMessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, header := range c.msg.Headers {
+		keys = append(keys, string(header.Key))
+	}
+
+	return keys
+}
+
+type consumerMessageCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (c consumerMessageCarrier) Get(key string) string {
+	for _, header := range c.msg.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c consumerMessageCarrier) Set(key, value string) {
+	for _, header := range c.msg.Headers {
+		if header != nil && string(header.Key) == key {
+			header.Value = []byte(value)
+			return
+		}
+	}
+
+	c.msg.Headers = append(c.msg.Headers, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c consumerMessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, header := range c.msg.Headers {
+		if header != nil {
+			keys = append(keys, string(header.Key))
+		}
+	}
+
+	return keys
+}
+
+func Inject(ctx context.Context, msg *sarama.ProducerMessage) {
+	otel.GetTextMapPropagator().Inject(ctx, producerMessageCarrier{msg: msg})
+}
+
+func Extract(ctx context.Context, msg *sarama.ConsumerMessage) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, consumerMessageCarrier{msg: msg})
+}
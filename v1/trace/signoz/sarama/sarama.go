@@ -0,0 +1,119 @@
+package signozsarama
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	producerInterceptor struct{}
+	consumerInterceptor struct{}
+)
+
+func NewProducerInterceptor() sarama.ProducerInterceptor {
+	return producerInterceptor{}
+}
+
+func NewConsumerInterceptor() sarama.ConsumerInterceptor {
+	return consumerInterceptor{}
+}
+
+func (producerInterceptor) OnSend(msg *sarama.ProducerMessage) {
+	ctx, ok := msg.Metadata.(context.Context)
+	if !ok {
+		ctx = context.Background()
+	}
+
+	_, span := StartProducerSpan(ctx, msg)
+	span.End()
+}
+
+func (consumerInterceptor) OnConsume(msg *sarama.ConsumerMessage) {
+	ctx, span := signoz.Tracer().Start(
+		Extract(context.Background(), msg),
+		msg.Topic+" receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(consumerAttributes(msg, "receive")...),
+	)
+	span.End()
+
+	injectConsumer(ctx, msg)
+}
+
+func StartProducerSpan(ctx context.Context, msg *sarama.ProducerMessage) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("kafka"),
+		semconv.MessagingDestinationKey.String(msg.Topic),
+		semconv.MessagingDestinationKindTopic,
+	}
+	if key := encoderString(msg.Key); key != "" {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKeyKey.String(key))
+	}
+
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		msg.Topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attributes...),
+	)
+	Inject(ctx, msg)
+
+	return ctx, span
+}
+
+func StartConsumerSpan(ctx context.Context, msg *sarama.ConsumerMessage) (context.Context, trace.Span) {
+	return signoz.Tracer().Start(
+		Extract(ctx, msg),
+		msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(consumerAttributes(msg, "process")...),
+	)
+}
+
+func SetError(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+}
+
+func consumerAttributes(msg *sarama.ConsumerMessage, operation string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("kafka"),
+		semconv.MessagingDestinationKey.String(msg.Topic),
+		semconv.MessagingDestinationKindTopic,
+		semconv.MessagingOperationKey.String(operation),
+		semconv.MessagingMessageIDKey.String(strconv.FormatInt(msg.Offset, 10)),
+		semconv.MessagingKafkaPartitionKey.Int64(int64(msg.Partition)),
+	}
+	if len(msg.Key) > 0 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKeyKey.String(string(msg.Key)))
+	}
+
+	return attributes
+}
+
+func injectConsumer(ctx context.Context, msg *sarama.ConsumerMessage) {
+	otel.GetTextMapPropagator().Inject(ctx, consumerMessageCarrier{msg: msg})
+}
+
+func encoderString(encoder sarama.Encoder) string {
+	if encoder == nil {
+		return ""
+	}
+
+	b, err := encoder.Encode()
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -0,0 +1,224 @@
+package signozsoap
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elraghifary/go-modules/v1/mask"
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var elementPattern = regexp.MustCompile(`(?s)(<(?:[\w-]+:)?([\w.-]+)(?:\s[^>]*)?>)([^<]*)(</(?:[\w-]+:)?([\w.-]+)\s*>)`)
+
+type (
+	Client struct {
+		httpClient        *http.Client
+		sensitiveElements []string
+		elements          map[string]struct{}
+		maxBodySize       int
+	}
+
+	Option interface {
+		apply(Client) Client
+	}
+
+	option func(Client) Client
+)
+
+func (fn option) apply(c Client) Client {
+	return fn(c)
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return option(func(c Client) Client {
+		c.httpClient = httpClient
+		return c
+	})
+}
+
+func WithSensitiveElements(elements ...string) Option {
+	return option(func(c Client) Client {
+		c.sensitiveElements = append(c.sensitiveElements, elements...)
+		return c
+	})
+}
+
+func WithMaxBodySize(size int) Option {
+	return option(func(c Client) Client {
+		c.maxBodySize = size
+		return c
+	})
+}
+
+func New(opts ...Option) *Client {
+	c := Client{
+		httpClient:        http.DefaultClient,
+		sensitiveElements: []string{"password", "token", "secret", "cardnumber", "cvv", "pin"},
+		maxBodySize:       8192,
+	}
+	for _, opt := range opts {
+		c = opt.apply(c)
+	}
+
+	c.elements = make(map[string]struct{}, len(c.sensitiveElements))
+	for _, element := range c.sensitiveElements {
+		c.elements[strings.ToLower(element)] = struct{}{}
+	}
+
+	return &c
+}
+
+func (c *Client) Call(ctx context.Context, url, soapAction string, envelope []byte) ([]byte, error) {
+	ctx, span := signoz.Tracer().Start(
+		ctx,
+		"SOAP "+soapAction,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(http.MethodPost),
+			semconv.HTTPURLKey.String(signoz.SanitizeURL(url)),
+			attribute.String("soap.action", soapAction),
+			attribute.Int("soap.request.envelope_size", len(envelope)),
+		),
+	)
+	defer span.End()
+
+	if span.IsRecording() {
+		span.AddEvent("Request", trace.WithAttributes(
+			attribute.String("Envelope", c.sanitize(envelope)),
+		))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(envelope))
+	if err != nil {
+		setError(span, err)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", soapAction)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		setError(span, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		setError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		semconv.HTTPStatusCodeKey.Int(resp.StatusCode),
+		attribute.Int("soap.response.envelope_size", len(body)),
+	)
+	if span.IsRecording() {
+		span.AddEvent("Response", trace.WithAttributes(
+			attribute.String("Envelope", c.sanitize(body)),
+		))
+	}
+
+	if faultCode, faultString := parseFault(body); faultCode != "" {
+		span.SetAttributes(
+			attribute.String("soap.fault.code", faultCode),
+			attribute.String("soap.fault.string", faultString),
+		)
+		span.SetStatus(codes.Error, faultCode+": "+faultString)
+	} else {
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+	}
+
+	return body, nil
+}
+
+func (c *Client) sanitize(body []byte) string {
+	sanitized := elementPattern.ReplaceAllStringFunc(string(body), func(match string) string {
+		groups := elementPattern.FindStringSubmatch(match)
+		if !strings.EqualFold(groups[2], groups[5]) || !c.sensitive(groups[2]) {
+			return match
+		}
+
+		return groups[1] + mask.Value + groups[4]
+	})
+	sanitized = mask.String(sanitized)
+
+	if c.maxBodySize > 0 && len(sanitized) > c.maxBodySize {
+		n := c.maxBodySize
+		for n > 0 && !utf8.RuneStart(sanitized[n]) {
+			n--
+		}
+		sanitized = sanitized[:n] + "...(truncated)"
+	}
+
+	return sanitized
+}
+
+func (c *Client) sensitive(element string) bool {
+	if _, ok := c.elements[strings.ToLower(element)]; ok {
+		return true
+	}
+
+	return mask.IsKey(element)
+}
+
+func parseFault(body []byte) (string, string) {
+	var (
+		faultCode, faultString string
+		path                   []string
+	)
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path = append(path, strings.ToLower(t.Name.Local))
+		case xml.EndElement:
+			if len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+		case xml.CharData:
+			if len(path) == 0 {
+				continue
+			}
+
+			text := strings.TrimSpace(string(t))
+			current := path[len(path)-1]
+			switch {
+			case current == "faultcode":
+				faultCode = text
+			case current == "faultstring":
+				faultString = text
+			case current == "value" && len(path) > 1 && path[len(path)-2] == "code" && faultCode == "":
+				faultCode = text
+			case current == "text" && len(path) > 1 && path[len(path)-2] == "reason":
+				faultString = text
+			}
+		}
+	}
+
+	return faultCode, faultString
+}
+
+func setError(span trace.Span, err error) {
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+}
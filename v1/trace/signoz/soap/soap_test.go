@@ -0,0 +1,53 @@
+package signozsoap
+
+import "testing"
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		envelope string
+		want     string
+	}{
+		{"plain", nil, "<soap:Body><Login><user>bob</user></Login></soap:Body>", "<soap:Body><Login><user>bob</user></Login></soap:Body>"},
+		{"sensitive element", nil, "<Login><user>bob</user><password>hunter2</password></Login>", "<Login><user>bob</user><password>***</password></Login>"},
+		{"namespaced element", nil, `<ns:Token xmlns:ns="urn:x">abc</ns:Token>`, `<ns:Token xmlns:ns="urn:x">***</ns:Token>`},
+		{"case insensitive", nil, "<CardNumber>4111111111111111</CardNumber>", "<CardNumber>***</CardNumber>"},
+		{"mask key element", nil, "<access_token>abc</access_token>", "<access_token>***</access_token>"},
+		{"custom element", []Option{WithSensitiveElements("ssn")}, "<ssn>123-45-6789</ssn>", "<ssn>***</ssn>"},
+		{"mismatched tags", nil, "<password>a</user>", "<password>a</user>"},
+		{"truncated", []Option{WithMaxBodySize(10)}, "<user>bob</user>", "<user>bob<...(truncated)"},
+		{"truncated at rune boundary", []Option{WithMaxBodySize(7)}, "<user>é</user>", "<user>...(truncated)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.opts...).sanitize([]byte(tt.envelope)); got != tt.want {
+				t.Errorf("sanitize(%q) = %q, want %q", tt.envelope, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFault(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   string
+		wantString string
+	}{
+		{"no fault", "<Envelope><Body><Result>ok</Result></Body></Envelope>", "", ""},
+		{"soap 1.1", "<Envelope><Body><Fault><faultcode>soap:Server</faultcode><faultstring>boom</faultstring></Fault></Body></Envelope>", "soap:Server", "boom"},
+		{"soap 1.2", "<Envelope><Body><Fault><Code><Value>env:Sender</Value></Code><Reason><Text>bad input</Text></Reason></Fault></Body></Envelope>", "env:Sender", "bad input"},
+		{"invalid xml", "not xml", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, str := parseFault([]byte(tt.body))
+			if code != tt.wantCode || str != tt.wantString {
+				t.Errorf("parseFault(%q) = (%q, %q), want (%q, %q)", tt.body, code, str, tt.wantCode, tt.wantString)
+			}
+		})
+	}
+}
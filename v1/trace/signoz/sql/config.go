@@ -0,0 +1,91 @@
+package signozsql
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Option interface {
+	apply(config) config
+}
+
+type config struct {
+	DBSystem        signoz.DatabasePlatform
+	DBName          string
+	RecordStatement bool
+	Sanitizer       func(query string) string
+}
+
+type option func(config) config
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithDBName(dbName string) Option {
+	return option(func(config config) config {
+		config.DBName = dbName
+		return config
+	})
+}
+
+func WithStatement(sanitizer func(query string) string) Option {
+	return option(func(config config) config {
+		config.RecordStatement = true
+		config.Sanitizer = sanitizer
+		return config
+	})
+}
+
+func newConfig(dbSystem signoz.DatabasePlatform, opts []Option) config {
+	config := config{DBSystem: dbSystem}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	return config
+}
+
+func (c config) startSpan(ctx context.Context, method, query string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String(string(c.DBSystem)),
+	}
+	if c.DBName != "" {
+		attributes = append(attributes, semconv.DBNameKey.String(c.DBName))
+	}
+
+	name := method
+	if operation := signoz.SQLOperation(query); operation != "" {
+		name = operation
+		attributes = append(attributes, semconv.DBOperationKey.String(operation))
+	}
+
+	if c.RecordStatement && query != "" {
+		statement := signoz.SanitizeSQL(query)
+		if c.Sanitizer != nil {
+			statement = c.Sanitizer(query)
+		}
+		attributes = append(attributes, semconv.DBStatementKey.String(statement))
+	}
+
+	return signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil && err != driver.ErrSkip {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+	span.End()
+}
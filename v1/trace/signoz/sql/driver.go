@@ -0,0 +1,302 @@
+package signozsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+)
+
+type (
+	tracedDriver struct {
+		driver driver.Driver
+		config config
+	}
+
+	tracedConnector struct {
+		connector driver.Connector
+		driver    driver.Driver
+		config    config
+	}
+
+	tracedConn struct {
+		conn   driver.Conn
+		config config
+	}
+
+	tracedStmt struct {
+		stmt   driver.Stmt
+		query  string
+		config config
+	}
+
+	tracedTx struct {
+		tx     driver.Tx
+		ctx    context.Context
+		config config
+	}
+)
+
+func Wrap(d driver.Driver, dbSystem signoz.DatabasePlatform, opts ...Option) driver.Driver {
+	return &tracedDriver{driver: d, config: newConfig(dbSystem, opts)}
+}
+
+func Open(driverName, dataSourceName string, dbSystem signoz.DatabasePlatform, opts ...Option) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	d := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	config := newConfig(dbSystem, opts)
+
+	if driverContext, ok := d.(driver.DriverContext); ok {
+		connector, err := driverContext.OpenConnector(dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		return sql.OpenDB(&tracedConnector{connector: connector, driver: d, config: config}), nil
+	}
+
+	return sql.OpenDB(&tracedConnector{
+		connector: dsnConnector{dsn: dataSourceName, driver: d},
+		driver:    d,
+		config:    config,
+	}), nil
+}
+
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+func (d *tracedDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedConn{conn: conn, config: d.config}, nil
+}
+
+func (c *tracedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedConn{conn: conn, config: c.config}, nil
+}
+
+func (c *tracedConnector) Driver() driver.Driver {
+	return &tracedDriver{driver: c.driver, config: c.config}
+}
+
+func (c *tracedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	_, span := c.config.startSpan(ctx, "db.Prepare", query)
+
+	var (
+		stmt driver.Stmt
+		err  error
+	)
+	if preparer, ok := c.conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.conn.Prepare(query)
+	}
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedStmt{stmt: stmt, query: query, config: c.config}, nil
+}
+
+func (c *tracedConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *tracedConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	_, span := c.config.startSpan(ctx, "db.Begin", "")
+
+	var (
+		tx  driver.Tx
+		err error
+	)
+	if beginner, ok := c.conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.conn.Begin()
+	}
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedTx{tx: tx, ctx: ctx, config: c.config}, nil
+}
+
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	_, span := c.config.startSpan(ctx, "db.Exec", query)
+	result, err := execer.ExecContext(ctx, query, args)
+	endSpan(span, err)
+
+	return result, err
+}
+
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	_, span := c.config.startSpan(ctx, "db.Query", query)
+	rows, err := queryer.QueryContext(ctx, query, args)
+	endSpan(span, err)
+
+	return rows, err
+}
+
+func (c *tracedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+
+	return nil
+}
+
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+func (c *tracedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+
+	return driver.ErrSkip
+}
+
+func (s *tracedStmt) Close() error {
+	return s.stmt.Close()
+}
+
+func (s *tracedStmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+func (s *tracedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.stmt.Exec(args)
+}
+
+func (s *tracedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.stmt.Query(args)
+}
+
+func (s *tracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	_, span := s.config.startSpan(ctx, "db.Exec", s.query)
+
+	var (
+		result driver.Result
+		err    error
+	)
+	if execer, ok := s.stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		var values []driver.Value
+		values, err = namedValuesToValues(args)
+		if err == nil {
+			result, err = s.stmt.Exec(values)
+		}
+	}
+	endSpan(span, err)
+
+	return result, err
+}
+
+func (s *tracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	_, span := s.config.startSpan(ctx, "db.Query", s.query)
+
+	var (
+		rows driver.Rows
+		err  error
+	)
+	if queryer, ok := s.stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		var values []driver.Value
+		values, err = namedValuesToValues(args)
+		if err == nil {
+			rows, err = s.stmt.Query(values)
+		}
+	}
+	endSpan(span, err)
+
+	return rows, err
+}
+
+func (s *tracedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+
+	return driver.ErrSkip
+}
+
+func (t *tracedTx) Commit() error {
+	_, span := t.config.startSpan(t.ctx, "db.Commit", "")
+	err := t.tx.Commit()
+	endSpan(span, err)
+
+	return err
+}
+
+func (t *tracedTx) Rollback() error {
+	_, span := t.config.startSpan(t.ctx, "db.Rollback", "")
+	err := t.tx.Rollback()
+	endSpan(span, err)
+
+	return err
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, driver.ErrSkip
+		}
+		values[i] = arg.Value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,120 @@
+package signozsqlx
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var dbSystemMapper = map[string]string{
+	"postgres": "postgresql",
+	"pgx":      "postgresql",
+	"sqlite3":  "sqlite",
+}
+
+func GetContext(ctx context.Context, q sqlx.ExtContext, name string, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := startSpan(ctx, q.DriverName(), name, "get", query)
+	err := sqlx.GetContext(ctx, q, dest, query, args...)
+	endSpan(span, err)
+
+	return err
+}
+
+func SelectContext(ctx context.Context, q sqlx.ExtContext, name string, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := startSpan(ctx, q.DriverName(), name, "select", query)
+	err := sqlx.SelectContext(ctx, q, dest, query, args...)
+	endSpan(span, err)
+
+	return err
+}
+
+func ExecContext(ctx context.Context, e sqlx.ExtContext, name string, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startSpan(ctx, e.DriverName(), name, "exec", query)
+	result, err := e.ExecContext(ctx, query, args...)
+	setRowsAffected(span, result, err)
+	endSpan(span, err)
+
+	return result, err
+}
+
+func NamedExecContext(ctx context.Context, e sqlx.ExtContext, name string, query string, arg interface{}) (sql.Result, error) {
+	ctx, span := startSpan(ctx, e.DriverName(), name, "named_exec", query)
+	result, err := sqlx.NamedExecContext(ctx, e, query, arg)
+	setRowsAffected(span, result, err)
+	endSpan(span, err)
+
+	return result, err
+}
+
+func WithTx(ctx context.Context, db *sqlx.DB, name string, opts *sql.TxOptions, fn func(ctx context.Context, tx *sqlx.Tx) error) (err error) {
+	ctx, span := startSpan(ctx, db.DriverName(), name, "transaction", "")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	tx, err := db.BeginTxx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = fn(ctx, tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			span.RecordError(rollbackErr)
+		}
+		span.AddEvent("rollback")
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	span.AddEvent("commit")
+
+	return nil
+}
+
+func startSpan(ctx context.Context, driverName, name, operation, query string) (context.Context, trace.Span) {
+	dbSystem, ok := dbSystemMapper[driverName]
+	if !ok {
+		dbSystem = driverName
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemKey.String(dbSystem),
+		semconv.DBOperationKey.String(operation),
+	}
+	if query != "" {
+		attributes = append(attributes, semconv.DBStatementKey.String(signoz.SanitizeSQL(query)))
+	}
+
+	return signoz.Tracer().Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func setRowsAffected(span trace.Span, result sql.Result, err error) {
+	if err != nil || result == nil {
+		return
+	}
+
+	if rowsAffected, err := result.RowsAffected(); err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", rowsAffected))
+	}
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,141 @@
+package signoztemporal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/interceptor"
+)
+
+type (
+	Options struct {
+		LinkWorkflows        bool
+		DisableSignalTracing bool
+		DisableQueryTracing  bool
+	}
+
+	tracer struct {
+		interceptor.BaseTracer
+		options Options
+	}
+
+	tracerSpan struct {
+		trace.Span
+	}
+
+	tracerSpanRef struct {
+		trace.SpanContext
+	}
+
+	spanContextKey struct{}
+)
+
+var spanKindMapper = map[string]trace.SpanKind{
+	"StartWorkflow":           trace.SpanKindClient,
+	"SignalWorkflow":          trace.SpanKindClient,
+	"SignalWithStartWorkflow": trace.SpanKindClient,
+	"QueryWorkflow":           trace.SpanKindClient,
+	"StartActivity":           trace.SpanKindClient,
+	"StartChildWorkflow":      trace.SpanKindClient,
+	"RunWorkflow":             trace.SpanKindServer,
+	"RunActivity":             trace.SpanKindServer,
+	"HandleSignal":            trace.SpanKindServer,
+	"HandleQuery":             trace.SpanKindServer,
+}
+
+func NewInterceptor(options Options) interceptor.Interceptor {
+	return interceptor.NewTracingInterceptor(&tracer{options: options})
+}
+
+func (t *tracer) Options() interceptor.TracerOptions {
+	return interceptor.TracerOptions{
+		SpanContextKey:       spanContextKey{},
+		HeaderKey:            "_tracer-data",
+		DisableSignalTracing: t.options.DisableSignalTracing,
+		DisableQueryTracing:  t.options.DisableQueryTracing,
+	}
+}
+
+func (t *tracer) UnmarshalSpan(m map[string]string) (interceptor.TracerSpanRef, error) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(m))
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil, fmt.Errorf("failed extracting span context from header")
+	}
+
+	return &tracerSpanRef{SpanContext: spanContext}, nil
+}
+
+func (t *tracer) MarshalSpan(span interceptor.TracerSpan) (map[string]string, error) {
+	data := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(trace.ContextWithSpan(context.Background(), span.(*tracerSpan).Span), data)
+
+	return data, nil
+}
+
+func (t *tracer) SpanFromContext(ctx context.Context) interceptor.TracerSpan {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+
+	return &tracerSpan{Span: span}
+}
+
+func (t *tracer) ContextWithSpan(ctx context.Context, span interceptor.TracerSpan) context.Context {
+	return trace.ContextWithSpan(ctx, span.(*tracerSpan).Span)
+}
+
+func (t *tracer) StartSpan(options *interceptor.TracerStartSpanOptions) (interceptor.TracerSpan, error) {
+	ctx := context.Background()
+
+	var parent trace.SpanContext
+	switch ref := options.Parent.(type) {
+	case *tracerSpan:
+		parent = ref.SpanContext()
+		ctx = trace.ContextWithSpan(ctx, ref.Span)
+	case *tracerSpanRef:
+		parent = ref.SpanContext
+		ctx = trace.ContextWithRemoteSpanContext(ctx, ref.SpanContext)
+	case nil:
+	default:
+		return nil, fmt.Errorf("unrecognized parent type %T", ref)
+	}
+
+	attributes := make([]attribute.KeyValue, 0, len(options.Tags)+2)
+	attributes = append(attributes,
+		attribute.String("temporal.operation", options.Operation),
+		attribute.String("temporal.name", options.Name),
+	)
+	for key, value := range options.Tags {
+		attributes = append(attributes, attribute.String(key, value))
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(spanKindMapper[options.Operation]),
+		trace.WithAttributes(attributes...),
+		trace.WithTimestamp(options.Time),
+	}
+	if t.options.LinkWorkflows && options.Operation == "RunWorkflow" && parent.IsValid() {
+		opts = append(opts, trace.WithNewRoot(), trace.WithLinks(trace.Link{SpanContext: parent}))
+	}
+
+	_, span := signoz.Tracer().Start(ctx, t.SpanName(options), opts...)
+
+	return &tracerSpan{Span: span}, nil
+}
+
+func (s *tracerSpan) Finish(options *interceptor.TracerFinishSpanOptions) {
+	if options.Error != nil {
+		s.SetStatus(codes.Error, options.Error.Error())
+		s.RecordError(options.Error)
+	}
+	s.End()
+}
@@ -0,0 +1,31 @@
+package signoz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	uiURL     atomic.Value
+	uiURLOnce sync.Once
+)
+
+func setUIURL(url string) {
+	uiURLOnce.Do(func() {
+		uiURL.Store(strings.TrimRight(url, "/"))
+	})
+}
+
+func TraceURL(ctx context.Context) string {
+	url, _ := uiURL.Load().(string)
+	spanContext := trace.SpanContextFromContext(ctx)
+	if url == "" || !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return url + "/trace/" + spanContext.TraceID().String()
+}
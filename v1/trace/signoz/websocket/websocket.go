@@ -0,0 +1,148 @@
+package signozwebsocket
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/elraghifary/go-modules/v1/trace/signoz"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	Option interface {
+		apply(config) config
+	}
+
+	config struct {
+		LinkedMessages bool
+		Route          string
+	}
+
+	option func(config) config
+
+	MessageHandler func(ctx context.Context, messageType int, data []byte) error
+
+	Conn struct {
+		*websocket.Conn
+		ID     string
+		ctx    context.Context
+		config config
+	}
+)
+
+var messageTypeMapper = map[int]string{
+	websocket.TextMessage:   "text",
+	websocket.BinaryMessage: "binary",
+	websocket.CloseMessage:  "close",
+	websocket.PingMessage:   "ping",
+	websocket.PongMessage:   "pong",
+}
+
+func (fn option) apply(config config) config {
+	return fn(config)
+}
+
+func WithLinkedMessages() Option {
+	return option(func(config config) config {
+		config.LinkedMessages = true
+		return config
+	})
+}
+
+func WithRoute(route string) Option {
+	return option(func(config config) config {
+		config.Route = route
+		return config
+	})
+}
+
+func Upgrade(upgrader *websocket.Upgrader, w http.ResponseWriter, r *http.Request, responseHeader http.Header, opts ...Option) (*Conn, error) {
+	config := config{}
+	for _, opt := range opts {
+		config = opt.apply(config)
+	}
+
+	start := time.Now()
+	connectionID := newConnectionID()
+
+	_, span := signoz.StartHTTPServerSpan(r, config.Route)
+	defer span.End()
+
+	if config.Route != "" {
+		span.SetName("WS " + config.Route)
+	} else {
+		span.SetName("WS")
+	}
+	span.SetAttributes(attribute.String("websocket.connection_id", connectionID))
+
+	conn, err := upgrader.Upgrade(w, r, responseHeader)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, err
+	}
+	signoz.EndHTTPServerSpan(span, http.StatusSwitchingProtocols, 0, start)
+
+	return &Conn{
+		Conn:   conn,
+		ID:     connectionID,
+		ctx:    trace.ContextWithSpanContext(context.Background(), span.SpanContext()),
+		config: config,
+	}, nil
+}
+
+func (c *Conn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *Conn) HandleMessage(handler MessageHandler) error {
+	messageType, data, err := c.ReadMessage()
+	if err != nil {
+		return err
+	}
+
+	return c.TraceMessage(messageType, data, handler)
+}
+
+func (c *Conn) TraceMessage(messageType int, data []byte, handler MessageHandler) error {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("websocket.connection_id", c.ID),
+			attribute.String("websocket.message_type", messageTypeMapper[messageType]),
+			attribute.Int("websocket.message_size", len(data)),
+		),
+	}
+
+	ctx := c.ctx
+	if c.config.LinkedMessages {
+		opts = append(opts, trace.WithNewRoot(), trace.WithLinks(trace.LinkFromContext(c.ctx)))
+		ctx = context.Background()
+	}
+
+	ctx, span := signoz.Tracer().Start(ctx, "WS message", opts...)
+	defer span.End()
+
+	err := handler(ctx, messageType, data)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+func newConnectionID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,71 @@
+package tempo
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+type (
+	tempo struct {
+		config       otel.Config
+		collectorURL string
+		insecure     string
+		protocol     string
+		headers      map[string]string
+	}
+
+	Config struct {
+		otel.Config
+		CollectorURL string
+		Insecure     string
+		Protocol     string
+		TenantID     string
+		Username     string
+		Password     string
+		Headers      map[string]string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	t := &tempo{
+		config:       cfg.Config,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+		headers:      newHeaders(cfg),
+	}
+
+	return otel.New(cfg.Config, t.InitTracer)
+}
+
+func newHeaders(cfg Config) map[string]string {
+	headers := make(map[string]string, len(cfg.Headers)+2)
+	for key, value := range cfg.Headers {
+		headers[key] = value
+	}
+
+	if cfg.TenantID != "" {
+		headers["X-Scope-OrgID"] = cfg.TenantID
+	}
+
+	if cfg.Username != "" {
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(cfg.Username+":"+cfg.Password))
+	}
+
+	return headers
+}
+
+func (t *tempo) InitTracer() func(context.Context) error {
+	exporter, err := otlptrace.New(context.Background(), otel.NewOTLPClient(t.collectorURL, t.insecure, t.protocol, t.headers))
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	return otel.InitConfigProvider(t.config, exporter)
+}
@@ -0,0 +1,53 @@
+package xray
+
+import (
+	"context"
+	"log"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	awsxray "go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+type (
+	xray struct {
+		config       otel.Config
+		collectorURL string
+		insecure     string
+		protocol     string
+	}
+
+	Config struct {
+		otel.Config
+		CollectorURL string
+		Insecure     string
+		Protocol     string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	x := &xray{
+		config:       cfg.Config,
+		collectorURL: cfg.CollectorURL,
+		insecure:     cfg.Insecure,
+		protocol:     cfg.Protocol,
+	}
+
+	return otel.New(cfg.Config, x.InitTracer)
+}
+
+func (x *xray) InitTracer() func(context.Context) error {
+	exporter, err := otlptrace.New(context.Background(), otel.NewOTLPClient(x.collectorURL, x.insecure, x.protocol, nil))
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	return otel.InitConfigProvider(
+		x.config,
+		exporter,
+		otel.WithIDGenerator(awsxray.NewIDGenerator()),
+		otel.WithPropagators(awsxray.Propagator{}),
+	)
+}
@@ -0,0 +1,46 @@
+package zipkin
+
+import (
+	"context"
+	"log"
+
+	"github.com/elraghifary/go-modules/v1/trace/otel"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	zipkinexporter "go.opentelemetry.io/otel/exporters/zipkin"
+)
+
+type (
+	zipkin struct {
+		config       otel.Config
+		collectorURL string
+	}
+
+	Config struct {
+		otel.Config
+		CollectorURL string
+	}
+
+	Itf = otel.Itf
+)
+
+func New(cfg Config) Itf {
+	z := &zipkin{
+		config:       cfg.Config,
+		collectorURL: cfg.CollectorURL,
+	}
+
+	return otel.New(cfg.Config, z.InitTracer)
+}
+
+func (z *zipkin) InitTracer() func(context.Context) error {
+	exporter, err := zipkinexporter.New(z.collectorURL)
+	if err != nil {
+		log.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	return otel.InitConfigProvider(
+		z.config,
+		exporter,
+		otel.WithPropagators(b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader))),
+	)
+}